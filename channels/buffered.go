@@ -0,0 +1,177 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package channels
+
+import (
+	"context"
+	"sync/atomic"
+
+	"go.mway.dev/x/container/deque"
+)
+
+// An Unbounded is a channel adapter with an unbounded buffer: sends to its
+// input channel never block, and values are delivered to its output channel
+// in the order they were sent.
+type Unbounded[T any] struct {
+	pipe[T]
+}
+
+// NewUnbounded creates a new [Unbounded]. Its output channel is closed after
+// its input channel is closed and all buffered values have been received, or
+// immediately once ctx is done.
+func NewUnbounded[T any](ctx context.Context) *Unbounded[T] {
+	u := &Unbounded[T]{
+		pipe: newPipe(deque.New[T](0), false /* block */),
+	}
+	go u.run(ctx)
+	return u
+}
+
+// A Ring is a channel adapter with a fixed-size buffer that applies an
+// [deque.Policy] when the buffer is full, so that consumers see bounded
+// memory use regardless of how quickly values are sent.
+type Ring[T any] struct {
+	pipe[T]
+}
+
+// NewRing creates a new [Ring] that buffers up to size values and applies
+// policy once its buffer is full. Its output channel is closed after its
+// input channel is closed and all buffered values have been received, or
+// immediately once ctx is done. NewRing panics if size is less than one.
+func NewRing[T any](
+	ctx context.Context,
+	size int,
+	policy deque.Policy,
+) *Ring[T] {
+	if size < 1 {
+		panic("channels: ring size must be at least one")
+	}
+
+	// n.b. A Deque cannot block, so the pipe stops receiving once the
+	//      buffer is full instead.
+	block := policy == deque.Block
+	if block {
+		policy = deque.DropNewest
+	}
+
+	r := &Ring[T]{
+		pipe: newPipe(deque.NewBounded[T](size, policy), block),
+	}
+	go r.run(ctx)
+	return r
+}
+
+// Dropped returns the number of values that have been dropped because the
+// buffer was full.
+func (r *Ring[T]) Dropped() uint64 {
+	return r.dropped.Load()
+}
+
+// A pipe moves values from an input channel to an output channel through a
+// [deque.Deque] buffer. If block is set, the pipe stops receiving while the
+// buffer is full.
+type pipe[T any] struct {
+	in      chan T
+	out     chan T
+	buf     *deque.Deque[T]
+	len     atomic.Int64
+	dropped atomic.Uint64
+	block   bool
+}
+
+func newPipe[T any](buf *deque.Deque[T], block bool) pipe[T] {
+	return pipe[T]{
+		in:    make(chan T),
+		out:   make(chan T),
+		buf:   buf,
+		block: block,
+	}
+}
+
+// In returns the input channel. Closing it stops the adapter once all
+// buffered values have been received.
+func (p *pipe[T]) In() chan<- T {
+	return p.in
+}
+
+// Out returns the output channel.
+func (p *pipe[T]) Out() <-chan T {
+	return p.out
+}
+
+// Len returns the number of values currently buffered.
+func (p *pipe[T]) Len() int {
+	return int(p.len.Load())
+}
+
+func (p *pipe[T]) run(ctx context.Context) {
+	defer close(p.out)
+
+	for {
+		var (
+			in  = p.in
+			out chan T
+		)
+
+		front, ok := p.buf.MaybeFront()
+		if ok {
+			out = p.out
+		}
+		if p.block && p.buf.Len() == p.buf.Cap() {
+			in = nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case value, ok := <-in:
+			if !ok {
+				p.flush(ctx)
+				return
+			}
+			p.push(value)
+		case out <- front:
+			p.buf.PopFront()
+			p.len.Add(-1)
+		}
+	}
+}
+
+func (p *pipe[T]) push(value T) {
+	// n.b. If the buffer is full, its policy drops either value or the
+	//      oldest buffered value, so its length does not change.
+	n := p.buf.Len()
+	if !p.buf.TryPushBack(value) || p.buf.Len() == n {
+		p.dropped.Add(1)
+		return
+	}
+	p.len.Add(1)
+}
+
+func (p *pipe[T]) flush(ctx context.Context) {
+	for {
+		value, ok := p.buf.MaybePopFront()
+		if !ok || !Send(ctx, p.out, value) {
+			return
+		}
+		p.len.Add(-1)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package channels_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/channels"
+	"go.mway.dev/x/channels/channelstest"
+	"go.mway.dev/x/container/deque"
+)
+
+func TestUnbounded(t *testing.T) {
+	u := channels.NewUnbounded[int](context.Background())
+
+	// Sends never block, regardless of whether anything is receiving.
+	for i := range 1000 {
+		channelstest.RequireSend(t, u.In(), i, _timeout)
+	}
+	require.Eventually(t, func() bool {
+		return u.Len() == 1000
+	}, _timeout, time.Millisecond)
+
+	require.Equal(
+		t,
+		[]int{0, 1, 2},
+		channelstest.RequireRecvN(t, u.Out(), 3, _timeout),
+	)
+
+	close(u.In())
+	have := channelstest.RequireRecvAll(t, u.Out(), _timeout)
+	require.Len(t, have, 997)
+	for i, value := range have {
+		require.Equal(t, i+3, value)
+	}
+	require.Zero(t, u.Len())
+}
+
+func TestUnbounded_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	u := channels.NewUnbounded[int](ctx)
+
+	channelstest.RequireSend(t, u.In(), 1, _timeout)
+	cancel()
+	channelstest.RequireRecvOrClose(t, u.Out(), _timeout)
+	channelstest.RequireClosed(t, u.Out(), _timeout)
+}
+
+func TestRing_DropOldest(t *testing.T) {
+	r := channels.NewRing[int](context.Background(), 3, deque.DropOldest)
+
+	for i := range 10 {
+		channelstest.RequireSend(t, r.In(), i, _timeout)
+	}
+	close(r.In())
+
+	require.Equal(
+		t,
+		[]int{7, 8, 9},
+		channelstest.RequireRecvAll(t, r.Out(), _timeout),
+	)
+	require.EqualValues(t, 7, r.Dropped())
+	require.Zero(t, r.Len())
+}
+
+func TestRing_DropNewest(t *testing.T) {
+	r := channels.NewRing[int](context.Background(), 3, deque.DropNewest)
+
+	for i := range 10 {
+		channelstest.RequireSend(t, r.In(), i, _timeout)
+	}
+	require.Eventually(t, func() bool {
+		return r.Len() == 3
+	}, _timeout, time.Millisecond)
+	close(r.In())
+
+	require.Equal(
+		t,
+		[]int{0, 1, 2},
+		channelstest.RequireRecvAll(t, r.Out(), _timeout),
+	)
+	require.EqualValues(t, 7, r.Dropped())
+}
+
+func TestRing_Block(t *testing.T) {
+	r := channels.NewRing[int](context.Background(), 2, deque.Block)
+
+	channelstest.RequireSend(t, r.In(), 1, _timeout)
+	channelstest.RequireSend(t, r.In(), 2, _timeout)
+	channelstest.RequireNoSend(t, r.In(), 3, 10*time.Millisecond)
+
+	require.Equal(t, 1, channelstest.RequireRecv(t, r.Out(), _timeout))
+	channelstest.RequireSend(t, r.In(), 3, _timeout)
+	close(r.In())
+
+	require.Equal(
+		t,
+		[]int{2, 3},
+		channelstest.RequireRecvAll(t, r.Out(), _timeout),
+	)
+	require.Zero(t, r.Dropped())
+}
+
+func TestNewRing_InvalidSize(t *testing.T) {
+	require.Panics(t, func() {
+		channels.NewRing[int](context.Background(), 0, deque.Block)
+	})
+}
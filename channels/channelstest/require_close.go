@@ -0,0 +1,87 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package channelstest
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/channels"
+	"go.mway.dev/x/testing"
+)
+
+// RequireClosed fails t if ch yields a value or is not closed within timeout.
+func RequireClosed[T any](
+	t testing.T,
+	ch <-chan T,
+	timeout time.Duration,
+) {
+	ctx, cancel := context.WithTimeout(t.Context(), timeout)
+	defer cancel()
+	_, ok := channels.Recv(ctx, ch)
+	require.True(t, !ok && ctx.Err() == nil)
+}
+
+// RequireRecvN fails t if it does not receive n values from ch within
+// timeout. Otherwise, it returns the received values.
+func RequireRecvN[T any](
+	t testing.T,
+	ch <-chan T,
+	n int,
+	timeout time.Duration,
+) []T {
+	ctx, cancel := context.WithTimeout(t.Context(), timeout)
+	defer cancel()
+
+	values := make([]T, 0, n)
+	for len(values) < n {
+		value, ok := channels.Recv(ctx, ch)
+		if !ok {
+			require.True(t, ok)
+			break
+		}
+		values = append(values, value)
+	}
+	return values
+}
+
+// RequireRecvAll fails t if ch is not closed within timeout. Otherwise, it
+// returns all values received from ch before it was closed.
+func RequireRecvAll[T any](
+	t testing.T,
+	ch <-chan T,
+	timeout time.Duration,
+) []T {
+	ctx, cancel := context.WithTimeout(t.Context(), timeout)
+	defer cancel()
+
+	var values []T
+	for {
+		value, ok := channels.Recv(ctx, ch)
+		if !ok {
+			require.True(t, ctx.Err() == nil)
+			return values
+		}
+		values = append(values, value)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package channelstest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"go.mway.dev/x/channels/channelstest"
+	"go.mway.dev/x/testing/testingmock"
+)
+
+func TestRequireClosed(t *testing.T) {
+	ch := make(chan int, 1)
+
+	mockT := testingmock.NewMockT(gomock.NewController(t))
+	mockT.EXPECT().Context().Return(t.Context())
+	mockT.EXPECT().Errorf(gomock.Any(), gomock.Any()).Times(1)
+	mockT.EXPECT().FailNow().Times(1)
+	channelstest.RequireClosed(mockT, ch, 10*time.Millisecond)
+
+	ch <- 1
+	mockT.EXPECT().Context().Return(t.Context())
+	mockT.EXPECT().Errorf(gomock.Any(), gomock.Any()).Times(1)
+	mockT.EXPECT().FailNow().Times(1)
+	channelstest.RequireClosed(mockT, ch, 10*time.Millisecond)
+
+	close(ch)
+	mockT.EXPECT().Context().Return(t.Context())
+	channelstest.RequireClosed(mockT, ch, 10*time.Millisecond)
+}
+
+func TestRequireRecvN(t *testing.T) {
+	ch := make(chan int, 3)
+	defer safeClose(t, ch)
+
+	ch <- 1
+	ch <- 2
+	ch <- 3
+
+	mockT := testingmock.NewMockT(gomock.NewController(t))
+	mockT.EXPECT().Context().Return(t.Context())
+	require.Equal(
+		t,
+		[]int{1, 2},
+		channelstest.RequireRecvN(mockT, ch, 2, 10*time.Millisecond),
+	)
+
+	mockT.EXPECT().Context().Return(t.Context())
+	mockT.EXPECT().Errorf(gomock.Any(), gomock.Any()).Times(1)
+	mockT.EXPECT().FailNow().Times(1)
+	require.Equal(
+		t,
+		[]int{3},
+		channelstest.RequireRecvN(mockT, ch, 2, 10*time.Millisecond),
+	)
+}
+
+func TestRequireRecvAll(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+
+	mockT := testingmock.NewMockT(gomock.NewController(t))
+	mockT.EXPECT().Context().Return(t.Context())
+	mockT.EXPECT().Errorf(gomock.Any(), gomock.Any()).Times(1)
+	mockT.EXPECT().FailNow().Times(1)
+	require.Equal(
+		t,
+		[]int{1, 2},
+		channelstest.RequireRecvAll(mockT, ch, 10*time.Millisecond),
+	)
+
+	ch <- 3
+	close(ch)
+	mockT.EXPECT().Context().Return(t.Context())
+	require.Equal(
+		t,
+		[]int{3},
+		channelstest.RequireRecvAll(mockT, ch, 10*time.Millisecond),
+	)
+}
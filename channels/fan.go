@@ -0,0 +1,282 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package channels
+
+import (
+	"cmp"
+	"context"
+	"reflect"
+	"slices"
+	"sync"
+)
+
+// Merge forwards values from all of the given channels to a single output
+// channel. The output channel is closed once all inputs are closed or ctx is
+// done.
+func Merge[T any](ctx context.Context, chs ...<-chan T) <-chan T {
+	var (
+		out = make(chan T)
+		wg  sync.WaitGroup
+	)
+
+	for _, ch := range chs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			forward(ctx, ch, out)
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+// FanOut distributes values from in among n output channels according to
+// the configured [Strategy], such that each value is received by exactly one
+// output. The outputs are closed once in is closed or ctx is done. FanOut
+// panics if n is less than one.
+func FanOut[T any](
+	ctx context.Context,
+	in <-chan T,
+	n int,
+	opts ...Option,
+) []<-chan T {
+	var (
+		options = options{}.With(opts...)
+		outs    = makeOutputs[T](n, options.Buffer)
+	)
+
+	go func() {
+		defer closeAll(outs)
+
+		send := newFanOutSender(ctx, outs, options.Strategy)
+		for {
+			value, ok := Recv(ctx, in)
+			if !ok || !send(value) {
+				return
+			}
+		}
+	}()
+
+	return receiveOnly(outs)
+}
+
+// Tee copies each value from in to all n output channels, blocking until
+// every output has received the value before receiving the next. The outputs
+// are closed once in is closed or ctx is done. Tee panics if n is less than
+// one.
+func Tee[T any](
+	ctx context.Context,
+	in <-chan T,
+	n int,
+	opts ...Option,
+) []<-chan T {
+	var (
+		options = options{}.With(opts...)
+		outs    = makeOutputs[T](n, options.Buffer)
+	)
+
+	go func() {
+		defer closeAll(outs)
+
+		cases := newSendCases(ctx, outs)
+		for {
+			value, ok := Recv(ctx, in)
+			if !ok || !sendAll(cases, outs, value) {
+				return
+			}
+		}
+	}()
+
+	return receiveOnly(outs)
+}
+
+// Broadcast copies each value from in to all n output channels without
+// blocking: outputs that are not ready to receive a value (i.e., have no
+// waiting receiver or free buffer space) miss it, so a slow output never
+// delays the others. The outputs are closed once in is closed or ctx is done.
+// Broadcast panics if n is less than one.
+func Broadcast[T any](
+	ctx context.Context,
+	in <-chan T,
+	n int,
+	opts ...Option,
+) []<-chan T {
+	var (
+		options = options{}.With(opts...)
+		outs    = makeOutputs[T](n, options.Buffer)
+	)
+
+	go func() {
+		defer closeAll(outs)
+
+		for {
+			value, ok := Recv(ctx, in)
+			if !ok {
+				return
+			}
+
+			for _, out := range outs {
+				select {
+				case out <- value:
+				default:
+				}
+			}
+		}
+	}()
+
+	return receiveOnly(outs)
+}
+
+func forward[T any](ctx context.Context, in <-chan T, out chan<- T) {
+	for {
+		value, ok := Recv(ctx, in)
+		if !ok || !Send(ctx, out, value) {
+			return
+		}
+	}
+}
+
+func newFanOutSender[T any](
+	ctx context.Context,
+	outs []chan T,
+	strategy Strategy,
+) func(T) bool {
+	if strategy == LeastLoaded {
+		var (
+			cases = newSendCases(ctx, outs)
+			order = make([]int, len(outs))
+		)
+		return func(value T) bool {
+			return sendLeastLoaded(cases, outs, order, value)
+		}
+	}
+
+	var next int
+	return func(value T) bool {
+		ok := Send(ctx, outs[next], value)
+		next = (next + 1) % len(outs)
+		return ok
+	}
+}
+
+func sendLeastLoaded[T any](
+	cases []reflect.SelectCase,
+	outs []chan T,
+	order []int,
+	value T,
+) bool {
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(len(outs[a]), len(outs[b]))
+	})
+
+	for _, i := range order {
+		select {
+		case outs[i] <- value:
+			return true
+		default:
+		}
+	}
+
+	// All outputs are full; send to whichever is ready first.
+	// n.b. ValueOf(value) is invalid if T is an interface and value is nil.
+	rv := reflect.ValueOf(&value).Elem()
+	for i := range outs {
+		cases[i+1].Send = rv
+	}
+	chosen, _, _ := reflect.Select(cases)
+	return chosen != 0
+}
+
+// newSendCases returns select cases for ctx.Done() followed by a send to each
+// of outs.
+func newSendCases[T any](
+	ctx context.Context,
+	outs []chan T,
+) []reflect.SelectCase {
+	cases := make([]reflect.SelectCase, len(outs)+1)
+	cases[0] = reflect.SelectCase{
+		Dir:  reflect.SelectRecv,
+		Chan: reflect.ValueOf(ctx.Done()),
+	}
+	for i := range outs {
+		cases[i+1] = reflect.SelectCase{
+			Dir:  reflect.SelectSend,
+			Chan: reflect.ValueOf(outs[i]),
+		}
+	}
+	return cases
+}
+
+// sendAll sends value to each of outs in whichever order they become ready,
+// returning false if ctx is done first.
+func sendAll[T any](cases []reflect.SelectCase, outs []chan T, value T) bool {
+	// n.b. ValueOf(value) is invalid if T is an interface and value is nil.
+	rv := reflect.ValueOf(&value).Elem()
+	for i := range outs {
+		cases[i+1].Chan = reflect.ValueOf(outs[i])
+		cases[i+1].Send = rv
+	}
+
+	for remaining := len(outs); remaining > 0; remaining-- {
+		chosen, _, _ := reflect.Select(cases)
+		if chosen == 0 {
+			return false
+		}
+		// n.b. Select ignores cases with a zero Chan.
+		cases[chosen].Chan = reflect.Value{}
+	}
+
+	return true
+}
+
+func makeOutputs[T any](n int, size int) []chan T {
+	if n < 1 {
+		panic("channels: at least one output is required")
+	}
+
+	outs := make([]chan T, n)
+	for i := range outs {
+		outs[i] = make(chan T, size)
+	}
+	return outs
+}
+
+func receiveOnly[T any](chs []chan T) []<-chan T {
+	outs := make([]<-chan T, len(chs))
+	for i := range chs {
+		outs[i] = chs[i]
+	}
+	return outs
+}
+
+func closeAll[T any](chs []chan T) {
+	for _, ch := range chs {
+		close(ch)
+	}
+}
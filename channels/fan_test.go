@@ -0,0 +1,314 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package channels_test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/channels"
+	"go.mway.dev/x/channels/channelstest"
+)
+
+const _timeout = time.Second
+
+func TestMerge(t *testing.T) {
+	var (
+		a   = newClosedChannel(1, 2, 3)
+		b   = newClosedChannel(4, 5)
+		out = channels.Merge(context.Background(), a, b)
+	)
+
+	have := channelstest.RequireRecvAll(t, out, _timeout)
+	slices.Sort(have)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, have)
+}
+
+func TestMerge_NoInputs(t *testing.T) {
+	out := channels.Merge[int](context.Background())
+	channelstest.RequireClosed(t, out, _timeout)
+}
+
+func TestMerge_Canceled(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		in          = make(chan int)
+		out         = channels.Merge(ctx, in)
+	)
+	defer close(in)
+
+	in <- 1
+	require.Equal(t, 1, channelstest.RequireRecv(t, out, _timeout))
+
+	cancel()
+	channelstest.RequireClosed(t, out, _timeout)
+}
+
+func TestFanOut_RoundRobin(t *testing.T) {
+	var (
+		in   = newClosedChannel(0, 1, 2, 3, 4, 5)
+		outs = channels.FanOut(
+			context.Background(),
+			in,
+			3,
+			channels.WithBuffer(2),
+		)
+	)
+
+	require.Len(t, outs, 3)
+	for i, out := range outs {
+		require.Equal(
+			t,
+			[]int{i, i + 3},
+			channelstest.RequireRecvAll(t, out, _timeout),
+		)
+	}
+}
+
+func TestFanOut_LeastLoaded(t *testing.T) {
+	var (
+		in   = make(chan int)
+		outs = channels.FanOut(
+			context.Background(),
+			in,
+			2,
+			channels.WithBuffer(4),
+			channels.WithStrategy(channels.LeastLoaded),
+		)
+	)
+
+	// With no consumers, values are spread evenly across the buffers.
+	for i := range 4 {
+		in <- i
+	}
+	require.Eventually(t, func() bool {
+		return len(outs[0]) == 2 && len(outs[1]) == 2
+	}, _timeout, time.Millisecond)
+	require.Equal(
+		t,
+		[]int{0, 2},
+		channelstest.RequireRecvN(t, outs[0], 2, _timeout),
+	)
+
+	// Draining one output makes it the least loaded.
+	in <- 4
+	in <- 5
+	close(in)
+
+	require.Equal(
+		t,
+		[]int{4, 5},
+		channelstest.RequireRecvAll(t, outs[0], _timeout),
+	)
+	require.Equal(
+		t,
+		[]int{1, 3},
+		channelstest.RequireRecvAll(t, outs[1], _timeout),
+	)
+}
+
+func TestFanOut_LeastLoaded_Blocking(t *testing.T) {
+	var (
+		in   = make(chan int)
+		outs = channels.FanOut(
+			context.Background(),
+			in,
+			3,
+			channels.WithStrategy(channels.LeastLoaded),
+		)
+		wg   sync.WaitGroup
+		have = make([][]int, len(outs))
+	)
+
+	for i, out := range outs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for value := range out {
+				have[i] = append(have[i], value)
+			}
+		}()
+	}
+
+	for i := range 100 {
+		in <- i
+	}
+	close(in)
+	wg.Wait()
+
+	all := slices.Concat(have...)
+	slices.Sort(all)
+	require.Len(t, all, 100)
+	for i, value := range all {
+		require.Equal(t, i, value)
+	}
+}
+
+func TestFanOut_LeastLoaded_NilInterface(t *testing.T) {
+	var (
+		in   = newClosedChannel[error](nil, nil, nil)
+		outs = channels.FanOut(
+			context.Background(),
+			in,
+			2,
+			channels.WithStrategy(channels.LeastLoaded),
+		)
+		have []error
+	)
+
+	// Both outputs are unbuffered, so every send waits for a receiver.
+	for _, out := range outs {
+		have = append(have, channelstest.RequireRecvAll(t, out, _timeout)...)
+	}
+	require.Equal(t, []error{nil, nil, nil}, have)
+}
+
+func TestFanOut_Canceled(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		in          = make(chan int)
+		outs        = channels.FanOut(ctx, in, 2)
+	)
+	defer close(in)
+
+	cancel()
+	for _, out := range outs {
+		channelstest.RequireClosed(t, out, _timeout)
+	}
+}
+
+func TestTee(t *testing.T) {
+	var (
+		in   = newClosedChannel(1, 2, 3)
+		outs = channels.Tee(context.Background(), in, 3)
+		wg   sync.WaitGroup
+	)
+
+	// Receive in different orders to ensure that Tee does not require its
+	// outputs to be read in any particular order.
+	for i, out := range slices.Backward(outs) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			time.Sleep(time.Duration(i) * time.Millisecond)
+			require.Equal(
+				t,
+				[]int{1, 2, 3},
+				channelstest.RequireRecvAll(t, out, _timeout),
+			)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestTee_NilInterface(t *testing.T) {
+	var (
+		in   = newClosedChannel[error](nil, nil)
+		outs = channels.Tee(context.Background(), in, 2)
+		wg   sync.WaitGroup
+	)
+
+	for _, out := range outs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.Equal(
+				t,
+				[]error{nil, nil},
+				channelstest.RequireRecvAll(t, out, _timeout),
+			)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestTee_Canceled(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		in          = newChannel(1)
+		outs        = channels.Tee(ctx, in, 2)
+	)
+	defer close(in)
+
+	// The first output receives the value, but the second never does.
+	require.Equal(t, 1, channelstest.RequireRecv(t, outs[0], _timeout))
+
+	cancel()
+	for _, out := range outs {
+		channelstest.RequireClosed(t, out, _timeout)
+	}
+}
+
+func TestBroadcast(t *testing.T) {
+	var (
+		in   = make(chan int)
+		outs = channels.Broadcast(
+			context.Background(),
+			in,
+			2,
+			channels.WithBuffer(1),
+		)
+	)
+
+	in <- 1
+	require.Equal(t, 1, channelstest.RequireRecv(t, outs[0], _timeout))
+
+	// The second output's buffer is full, so it misses the next value.
+	in <- 2
+	in <- 3
+	close(in)
+
+	require.Equal(
+		t,
+		[]int{2},
+		channelstest.RequireRecvAll(t, outs[0], _timeout),
+	)
+	require.Equal(
+		t,
+		[]int{1},
+		channelstest.RequireRecvAll(t, outs[1], _timeout),
+	)
+}
+
+func TestFanOut_InvalidOutputs(t *testing.T) {
+	require.Panics(t, func() {
+		channels.FanOut(context.Background(), make(chan int), 0)
+	})
+}
+
+func newChannel[T any](values ...T) chan T {
+	ch := make(chan T, len(values))
+	for _, value := range values {
+		ch <- value
+	}
+	return ch
+}
+
+func newClosedChannel[T any](values ...T) chan T {
+	ch := newChannel(values...)
+	close(ch)
+	return ch
+}
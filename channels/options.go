@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package channels
+
+// A Strategy determines how [FanOut] distributes values among its outputs.
+type Strategy int
+
+const (
+	// RoundRobin sends each value to the next output in turn, blocking until
+	// that output receives it.
+	RoundRobin Strategy = iota
+	// LeastLoaded sends each value to the output with the fewest buffered
+	// values, or to whichever output is ready first if all are full.
+	LeastLoaded
+)
+
+// An Option configures functions like [FanOut], [Tee], and [Broadcast].
+// Options that do not apply to a given function are ignored.
+type Option interface {
+	apply(*options)
+}
+
+// WithBuffer returns a new [Option] that configures the buffer size of each
+// output channel.
+func WithBuffer(size int) Option {
+	return optionFunc(func(dst *options) {
+		dst.Buffer = max(size, 0)
+	})
+}
+
+// WithStrategy returns a new [Option] that configures how [FanOut]
+// distributes values among its outputs. The default is [RoundRobin].
+func WithStrategy(strategy Strategy) Option {
+	return optionFunc(func(dst *options) {
+		dst.Strategy = strategy
+	})
+}
+
+type options struct {
+	Buffer   int
+	Strategy Strategy
+}
+
+func (o options) With(opts ...Option) options {
+	for _, opt := range opts {
+		opt.apply(&o)
+	}
+	return o
+}
+
+type optionFunc func(*options)
+
+func (f optionFunc) apply(dst *options) {
+	f(dst)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package channels
+
+import (
+	"context"
+)
+
+// ParallelMap applies fn to each value from in using up to workers
+// goroutines, and sends the results to the returned channel in the same order
+// as their inputs. At most workers values are being processed or awaiting
+// emission at any time, which bounds the memory used to reorder results. The
+// output channel is closed once in is closed and all results have been sent,
+// or ctx is done. ParallelMap panics if workers is less than one.
+func ParallelMap[T any, U any](
+	ctx context.Context,
+	in <-chan T,
+	workers int,
+	fn func(context.Context, T) U,
+	opts ...Option,
+) <-chan U {
+	if workers < 1 {
+		panic("channels: at least one worker is required")
+	}
+
+	var (
+		options = options{}.With(opts...)
+		out     = make(chan U, options.Buffer)
+		// n.b. The emitter holds one pending result while waiting for it, so
+		//      the queue only needs room for the rest.
+		pending = make(chan chan U, workers-1)
+	)
+
+	go func() {
+		defer close(pending)
+		for {
+			value, ok := Recv(ctx, in)
+			if !ok {
+				return
+			}
+
+			result := make(chan U, 1)
+			if !Send(ctx, pending, result) {
+				return
+			}
+			go func() {
+				result <- fn(ctx, value)
+			}()
+		}
+	}()
+
+	go func() {
+		defer close(out)
+		for result := range pending {
+			value, ok := Recv(ctx, result)
+			if !ok || !Send(ctx, out, value) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package channels_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/channels"
+	"go.mway.dev/x/channels/channelstest"
+	"go.mway.dev/x/sync/atomic"
+)
+
+func TestParallelMap(t *testing.T) {
+	const n = 100
+
+	var (
+		in      = make(chan int)
+		running atomic.Int[int64]
+		peak    atomic.Int[int64]
+		out     = channels.ParallelMap(
+			context.Background(),
+			in,
+			4,
+			func(_ context.Context, value int) string {
+				peak.Max(running.Inc())
+				defer running.Dec()
+
+				// Finish out of order to exercise reordering.
+				time.Sleep(time.Duration(n-value) * 10 * time.Microsecond)
+				return strconv.Itoa(value)
+			},
+		)
+	)
+
+	go func() {
+		defer close(in)
+		for i := range n {
+			in <- i
+		}
+	}()
+
+	have := channelstest.RequireRecvAll(t, out, 10*_timeout)
+	require.Len(t, have, n)
+	for i, value := range have {
+		require.Equal(t, strconv.Itoa(i), value)
+	}
+	require.LessOrEqual(t, peak.Load(), int64(4))
+}
+
+func TestParallelMap_Bounded(t *testing.T) {
+	var (
+		in      = newClosedChannel(0, 1, 2, 3, 4, 5)
+		release = make(chan struct{})
+		started atomic.Int[int64]
+		out     = channels.ParallelMap(
+			context.Background(),
+			in,
+			2,
+			func(_ context.Context, value int) int {
+				started.Inc()
+				if value == 0 {
+					<-release
+				}
+				return value
+			},
+		)
+	)
+
+	// The first value blocks emission, so no more than workers values may
+	// be started.
+	require.Eventually(t, func() bool {
+		return started.Load() == 2
+	}, _timeout, time.Millisecond)
+	channelstest.RequireNoRecv(t, out, 10*time.Millisecond)
+	require.EqualValues(t, 2, started.Load())
+
+	close(release)
+	require.Equal(
+		t,
+		[]int{0, 1, 2, 3, 4, 5},
+		channelstest.RequireRecvAll(t, out, _timeout),
+	)
+}
+
+func TestParallelMap_Canceled(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		in          = make(chan int)
+		block       = make(chan struct{})
+		out         = channels.ParallelMap(
+			ctx,
+			in,
+			2,
+			func(_ context.Context, value int) int {
+				<-block
+				return value
+			},
+		)
+	)
+	defer close(in)
+	defer close(block)
+
+	in <- 1
+	cancel()
+	channelstest.RequireClosed(t, out, _timeout)
+}
+
+func TestParallelMap_InvalidWorkers(t *testing.T) {
+	require.Panics(t, func() {
+		channels.ParallelMap(
+			context.Background(),
+			make(chan int),
+			0,
+			func(_ context.Context, value int) int { return value },
+		)
+	})
+}
@@ -33,6 +33,9 @@ var (
 	_newTimer = func(d time.Duration) *clock.Timer {
 		return _clock.NewTimer(d)
 	}
+	_newTicker = func(d time.Duration) *clock.Ticker {
+		return _clock.NewTicker(d)
+	}
 )
 
 // Send will send value to ch, blocking until either the send succeeds or the
@@ -0,0 +1,64 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package channels
+
+import (
+	"context"
+	"iter"
+)
+
+// Seq returns an [iter.Seq] that yields values received from ch until ch is
+// closed or ctx is done.
+func Seq[T any](ctx context.Context, ch <-chan T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			value, ok := Recv(ctx, ch)
+			if !ok || !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq returns a channel that receives each value yielded by seq. The
+// channel is closed once seq is exhausted or ctx is done; callers that stop
+// receiving before then should cancel ctx so that seq is not left blocked.
+func FromSeq[T any](
+	ctx context.Context,
+	seq iter.Seq[T],
+	opts ...Option,
+) <-chan T {
+	var (
+		options = options{}.With(opts...)
+		out     = make(chan T, options.Buffer)
+	)
+
+	go func() {
+		defer close(out)
+		for value := range seq {
+			if !Send(ctx, out, value) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package channels_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/channels"
+	"go.mway.dev/x/channels/channelstest"
+)
+
+func TestSeq(t *testing.T) {
+	in := newClosedChannel(1, 2, 3)
+	require.Equal(
+		t,
+		[]int{1, 2, 3},
+		slices.Collect(channels.Seq(context.Background(), in)),
+	)
+}
+
+func TestSeq_Break(t *testing.T) {
+	in := newChannel(1, 2, 3)
+	defer close(in)
+
+	for value := range channels.Seq(context.Background(), in) {
+		require.Equal(t, 1, value)
+		break
+	}
+	require.Equal(t, 2, <-in)
+}
+
+func TestSeq_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	in := make(chan int)
+	defer close(in)
+	require.Empty(t, slices.Collect(channels.Seq(ctx, in)))
+}
+
+func TestFromSeq(t *testing.T) {
+	out := channels.FromSeq(
+		context.Background(),
+		slices.Values([]int{1, 2, 3}),
+		channels.WithBuffer(3),
+	)
+	require.Equal(t, 3, cap(out))
+	require.Equal(
+		t,
+		[]int{1, 2, 3},
+		channelstest.RequireRecvAll(t, out, _timeout),
+	)
+}
+
+func TestFromSeq_Canceled(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		stopped     = make(chan struct{})
+		seq         = func(yield func(int) bool) {
+			defer close(stopped)
+			for i := 0; yield(i); i++ {
+			}
+		}
+		out = channels.FromSeq(ctx, seq)
+	)
+
+	require.Equal(t, 0, channelstest.RequireRecv(t, out, _timeout))
+	cancel()
+	channelstest.RequireRecvOrClose(t, stopped, _timeout)
+}
+
+func TestSeq_FromSeq_RoundTrip(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		want = []string{"a", "b", "c"}
+	)
+
+	require.Equal(
+		t,
+		want,
+		slices.Collect(channels.Seq(ctx, channels.FromSeq(
+			ctx,
+			slices.Values(want),
+		))),
+	)
+}
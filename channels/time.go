@@ -0,0 +1,274 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package channels
+
+import (
+	"context"
+	"time"
+
+	"go.mway.dev/chrono/clock"
+)
+
+// Batch groups values from in into batches, emitting a batch once it holds
+// maxSize values or maxWait has elapsed since its first value was received,
+// whichever comes first. A maxSize of zero or less does not limit the size of
+// batches, and a maxWait of zero or less does not limit their age. Once in is
+// closed, any partial batch is emitted and the output channel is closed; if
+// ctx is done, the output channel is closed immediately.
+func Batch[T any](
+	ctx context.Context,
+	in <-chan T,
+	maxSize int,
+	maxWait time.Duration,
+) <-chan []T {
+	b := &batcher[T]{
+		out:     make(chan []T),
+		maxSize: maxSize,
+		maxWait: maxWait,
+	}
+
+	go func() {
+		defer close(b.out)
+		defer b.timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case value, ok := <-in:
+				if !ok {
+					b.flush(ctx)
+					return
+				}
+				if !b.add(ctx, value) {
+					return
+				}
+			case <-b.timer.C():
+				if !b.flush(ctx) {
+					return
+				}
+			}
+		}
+	}()
+
+	return b.out
+}
+
+// Debounce emits the most recent value from in once no further values have
+// been received for wait. Once in is closed, any pending value is emitted and
+// the output channel is closed; if ctx is done, the output channel is closed
+// immediately.
+func Debounce[T any](
+	ctx context.Context,
+	in <-chan T,
+	wait time.Duration,
+) <-chan T {
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+
+		var (
+			latest  T
+			pending bool
+			timer   deadline
+		)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case value, ok := <-in:
+				if !ok {
+					if pending {
+						Send(ctx, out, latest)
+					}
+					return
+				}
+
+				latest, pending = value, true
+				timer.Start(wait)
+			case <-timer.C():
+				timer.Stop()
+				pending = false
+				if !Send(ctx, out, latest) {
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// Throttle emits at most one value from in per interval: a value is emitted
+// immediately if no value has been emitted within the last interval, and
+// dropped otherwise. The output channel is closed once in is closed or ctx is
+// done.
+func Throttle[T any](
+	ctx context.Context,
+	in <-chan T,
+	interval time.Duration,
+) <-chan T {
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+
+		var timer deadline
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case value, ok := <-in:
+				if !ok {
+					return
+				}
+				if timer.Active() {
+					continue
+				}
+				if !Send(ctx, out, value) {
+					return
+				}
+				timer.Start(interval)
+			case <-timer.C():
+				timer.Stop()
+			}
+		}
+	}()
+
+	return out
+}
+
+// Window groups values from in into consecutive, non-overlapping windows of
+// the given duration, emitting each window's values when it ends. Windows
+// without values are not emitted. Once in is closed, the values of the
+// current window are emitted and the output channel is closed; if ctx is
+// done, the output channel is closed immediately.
+func Window[T any](
+	ctx context.Context,
+	in <-chan T,
+	size time.Duration,
+) <-chan []T {
+	out := make(chan []T)
+
+	go func() {
+		defer close(out)
+
+		ticker := _newTicker(size)
+		defer ticker.Stop()
+
+		var window []T
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case value, ok := <-in:
+				if !ok {
+					if len(window) > 0 {
+						Send(ctx, out, window)
+					}
+					return
+				}
+				window = append(window, value)
+			case <-ticker.C:
+				if len(window) == 0 {
+					continue
+				}
+				if !Send(ctx, out, window) {
+					return
+				}
+				window = nil
+			}
+		}
+	}()
+
+	return out
+}
+
+type batcher[T any] struct {
+	out     chan []T
+	batch   []T
+	timer   deadline
+	maxSize int
+	maxWait time.Duration
+}
+
+// add adds value to the current batch, emitting the batch if it is full.
+// It returns false if the batch could not be emitted.
+func (b *batcher[T]) add(ctx context.Context, value T) bool {
+	b.batch = append(b.batch, value)
+	if len(b.batch) == 1 && b.maxWait > 0 {
+		b.timer.Start(b.maxWait)
+	}
+	if len(b.batch) == b.maxSize {
+		return b.flush(ctx)
+	}
+	return true
+}
+
+// flush emits the current batch, if any. It returns false if the batch could
+// not be emitted.
+func (b *batcher[T]) flush(ctx context.Context) bool {
+	b.timer.Stop()
+	if len(b.batch) == 0 {
+		return true
+	}
+	ok := Send(ctx, b.out, b.batch)
+	b.batch = nil
+	return ok
+}
+
+// A deadline is a restartable timer whose channel is nil while it is stopped,
+// such that it can be used unconditionally in a select statement.
+type deadline struct {
+	timer *clock.Timer
+}
+
+// Start (re)starts d with the given duration.
+func (d *deadline) Start(duration time.Duration) {
+	d.Stop()
+	d.timer = _newTimer(duration)
+}
+
+// Stop stops d.
+func (d *deadline) Stop() {
+	if d.timer != nil {
+		d.timer.Stop()
+		d.timer = nil
+	}
+}
+
+// Active reports whether d has been started and not yet stopped.
+func (d *deadline) Active() bool {
+	return d.timer != nil
+}
+
+// C returns d's channel, or nil if d is not active.
+func (d *deadline) C() <-chan time.Time {
+	if d.timer == nil {
+		return nil
+	}
+	return d.timer.C
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package channels
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/chrono/clock"
+
+	"go.mway.dev/x/stub"
+)
+
+func TestBatch(t *testing.T) {
+	withFakeClock(t, func(clk *clock.FakeClock, timers <-chan time.Duration) {
+		var (
+			in  = make(chan int)
+			out = Batch(context.Background(), in, 3, time.Second)
+		)
+
+		// Full batches are emitted immediately.
+		for i := range 3 {
+			in <- i
+		}
+		require.Equal(t, []int{0, 1, 2}, recvWithin(t, out))
+		require.Equal(t, time.Second, <-timers)
+
+		// Partial batches are emitted once their first value is maxWait old.
+		in <- 3
+		require.Equal(t, time.Second, <-timers)
+		in <- 4
+		requireNoRecv(t, out)
+		clk.Add(time.Second)
+		require.Equal(t, []int{3, 4}, recvWithin(t, out))
+
+		// Remaining values are emitted when the input is closed.
+		in <- 5
+		close(in)
+		require.Equal(t, []int{5}, recvWithin(t, out))
+		requireClosed(t, out)
+	})
+}
+
+func TestBatch_Unbounded(t *testing.T) {
+	in := newChannel(1, 2, 3, 4, 5)
+	close(in)
+
+	out := Batch(context.Background(), in, 0, 0)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, recvWithin(t, out))
+	requireClosed(t, out)
+}
+
+func TestBatch_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		in  = newChannel(1)
+		out = Batch(ctx, in, 2, 0)
+	)
+	defer close(in)
+
+	cancel()
+	requireClosed(t, out)
+}
+
+func TestDebounce(t *testing.T) {
+	withFakeClock(t, func(clk *clock.FakeClock, timers <-chan time.Duration) {
+		var (
+			in  = make(chan int)
+			out = Debounce(context.Background(), in, time.Second)
+		)
+
+		for i := range 3 {
+			in <- i
+			require.Equal(t, time.Second, <-timers)
+			clk.Add(time.Second / 2)
+		}
+		requireNoRecv(t, out)
+
+		clk.Add(time.Second / 2)
+		require.Equal(t, 2, recvWithin(t, out))
+
+		// A pending value is emitted when the input is closed.
+		in <- 3
+		close(in)
+		require.Equal(t, 3, recvWithin(t, out))
+		requireClosed(t, out)
+	})
+}
+
+func TestThrottle(t *testing.T) {
+	withFakeClock(t, func(clk *clock.FakeClock, timers <-chan time.Duration) {
+		var (
+			in  = make(chan int)
+			out = Throttle(context.Background(), in, time.Second)
+		)
+
+		in <- 1
+		require.Equal(t, 1, recvWithin(t, out))
+		require.Equal(t, time.Second, <-timers)
+
+		// Values received within the interval are dropped.
+		in <- 2
+		in <- 3
+		requireNoRecv(t, out)
+
+		// Once the interval elapses, the next value is emitted.
+		clk.Add(time.Second)
+		// n.b. Keep sending until the expiry is observed, always being ready
+		//      to receive so that an emitted value cannot block the sender.
+		timeout := time.After(time.Second)
+	loop:
+		for {
+			select {
+			case in <- 4:
+			case value := <-out:
+				require.Equal(t, 4, value)
+				break loop
+			case <-timeout:
+				require.FailNow(t, "timed out waiting for throttle")
+			}
+		}
+
+		close(in)
+		requireClosed(t, out)
+	})
+}
+
+func TestWindow(t *testing.T) {
+	withFakeClock(t, func(clk *clock.FakeClock, _ <-chan time.Duration) {
+		var (
+			in  = make(chan int)
+			out = Window(context.Background(), in, time.Second)
+		)
+
+		in <- 1
+		in <- 2
+		clk.Add(time.Second)
+		require.Equal(t, []int{1, 2}, recvWithin(t, out))
+
+		// Empty windows are not emitted.
+		clk.Add(time.Second)
+		requireNoRecv(t, out)
+
+		in <- 3
+		close(in)
+		require.Equal(t, []int{3}, recvWithin(t, out))
+		requireClosed(t, out)
+	})
+}
+
+// withFakeClock calls fn with timers and tickers stubbed to use a fake clock.
+// The duration of each timer is sent to timers when it is created.
+func withFakeClock(
+	t *testing.T,
+	fn func(clk *clock.FakeClock, timers <-chan time.Duration),
+) {
+	t.Helper()
+
+	var (
+		clk       = clock.NewFakeClock()
+		timers    = make(chan time.Duration, 64)
+		newTicker = clk.NewTicker
+		newTimer  = func(d time.Duration) *clock.Timer {
+			timer := clk.NewTimer(d)
+			timers <- d
+			return timer
+		}
+	)
+
+	stub.With(&_newTimer, newTimer, func() {
+		stub.With(&_newTicker, newTicker, func() {
+			fn(clk, timers)
+		})
+	})
+}
+
+func recvWithin[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+
+	select {
+	case value, ok := <-ch:
+		require.True(t, ok, "channel closed")
+		return value
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for receive")
+		panic("unreachable")
+	}
+}
+
+func requireNoRecv[T any](t *testing.T, ch <-chan T) {
+	t.Helper()
+
+	select {
+	case value := <-ch:
+		require.FailNow(t, "unexpected receive", "%v", value)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func requireClosed[T any](t *testing.T, ch <-chan T) {
+	t.Helper()
+
+	select {
+	case value, ok := <-ch:
+		require.False(t, ok, "unexpected receive: %v", value)
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for close")
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package deque_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/container/deque"
+)
+
+func TestDeque_RingBuffer(t *testing.T) {
+	var (
+		d     deque.Deque[int]
+		model []int
+		rng   = rand.New(rand.NewPCG(1, 2))
+	)
+
+	// Compare against a slice model across many wrap-arounds and resizes.
+	for i := range 10_000 {
+		switch rng.IntN(4) {
+		case 0:
+			d.PushFront(i)
+			model = slices.Insert(model, 0, i)
+		case 1:
+			d.PushBack(i)
+			model = append(model, i)
+		case 2:
+			x, ok := d.MaybePopFront()
+			require.Equal(t, len(model) > 0, ok)
+			if ok {
+				require.Equal(t, model[0], x)
+				model = model[1:]
+			}
+		case 3:
+			x, ok := d.MaybePopBack()
+			require.Equal(t, len(model) > 0, ok)
+			if ok {
+				require.Equal(t, model[len(model)-1], x)
+				model = model[:len(model)-1]
+			}
+		}
+
+		require.Equal(t, len(model), d.Len())
+	}
+
+	require.Equal(t, model, values(d.All()))
+}
+
+func TestDeque_AtSet(t *testing.T) {
+	d := deque.New[int](4)
+	d.PushBack(2)
+	d.PushBack(3)
+	d.PushFront(1)
+	d.PushFront(0)
+	d.PushBack(4)
+
+	for i := range d.Len() {
+		require.Equal(t, i, d.At(i))
+		d.Set(i, i*10)
+	}
+	require.Equal(t, []int{0, 10, 20, 30, 40}, values(d.All()))
+
+	require.Panics(t, func() { d.At(-1) })
+	require.Panics(t, func() { d.At(5) })
+	require.Panics(t, func() { d.Set(5, 0) })
+}
+
+func TestDeque_AllBackward(t *testing.T) {
+	d := deque.NewBounded[int](4, deque.DropOldest)
+	for i := range 6 {
+		d.PushBack(i)
+	}
+
+	var (
+		indexes []int
+		have    []int
+	)
+	for i, x := range d.Backward() {
+		indexes = append(indexes, i)
+		have = append(have, x)
+	}
+	require.Equal(t, []int{3, 2, 1, 0}, indexes)
+	require.Equal(t, []int{5, 4, 3, 2}, have)
+
+	for i, x := range d.All() {
+		require.Equal(t, i+2, x)
+		if i == 1 {
+			break
+		}
+	}
+}
+
+func TestDeque_ClearGrowShrink(t *testing.T) {
+	d := deque.NewWithValues(1, 2, 3)
+	require.Equal(t, 3, d.Cap())
+
+	d.Grow(5)
+	require.Equal(t, 8, d.Cap())
+	require.Equal(t, []int{1, 2, 3}, values(d.All()))
+
+	d.Grow(1)
+	require.Equal(t, 8, d.Cap())
+
+	d.Shrink(0)
+	require.Equal(t, 3, d.Cap())
+	require.Equal(t, []int{1, 2, 3}, values(d.All()))
+
+	d.Clear()
+	require.Zero(t, d.Len())
+	require.Equal(t, 3, d.Cap())
+	d.Shrink(0)
+	require.Zero(t, d.Cap())
+	d.PushBack(1)
+	require.Equal(t, []int{1}, values(d.All()))
+
+	require.Panics(t, func() { d.Grow(-1) })
+}
+
+func TestNewBounded_DropNewest(t *testing.T) {
+	d := deque.NewBounded[int](2, deque.DropNewest)
+	require.True(t, d.TryPushBack(1))
+	require.True(t, d.TryPushFront(0))
+	require.False(t, d.TryPushBack(2))
+	require.False(t, d.TryPushFront(-1))
+	d.PushBack(2)
+	require.Equal(t, []int{0, 1}, values(d.All()))
+
+	// Growing a bounded deque raises its bound.
+	d.Grow(1)
+	require.Equal(t, 3, d.Cap())
+	require.True(t, d.TryPushBack(2))
+	require.False(t, d.TryPushBack(3))
+
+	// Shrinking never discards values.
+	d.Shrink(1)
+	require.Equal(t, 3, d.Cap())
+	d.PopFront()
+	d.Shrink(1)
+	require.Equal(t, 2, d.Cap())
+	require.Equal(t, []int{1, 2}, values(d.All()))
+}
+
+func TestNewBounded_DropOldest(t *testing.T) {
+	d := deque.NewBounded[int](3, deque.DropOldest)
+	for i := range 5 {
+		d.PushBack(i)
+	}
+	require.Equal(t, []int{2, 3, 4}, values(d.All()))
+
+	// Pushing to the front evicts from the back.
+	d.PushFront(1)
+	require.Equal(t, []int{1, 2, 3}, values(d.All()))
+	require.Equal(t, 3, d.Cap())
+}
+
+func TestNewBounded_Invalid(t *testing.T) {
+	require.Panics(t, func() { deque.NewBounded[int](0, deque.DropNewest) })
+	require.Panics(t, func() { deque.NewBounded[int](1, deque.Block) })
+}
+
+func values[T any](seq func(func(int, T) bool)) []T {
+	var have []T
+	for _, x := range seq {
+		have = append(have, x)
+	}
+	return have
+}
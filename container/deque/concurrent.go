@@ -0,0 +1,227 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package deque
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	xsync "go.mway.dev/x/sync"
+)
+
+// ErrClosed is returned when pushing to a closed [Concurrent], or when popping
+// from one that is both closed and empty.
+var ErrClosed = errors.New("deque: deque is closed")
+
+// A Concurrent is a double-ended queue that is safe for concurrent use. Pops
+// may block until a value is available, and, if the deque is bounded, pushes
+// may block until there is room for another value.
+//
+// A zero Concurrent is an unbounded deque ready for use.
+type Concurrent[T any] struct {
+	notEmpty *xsync.Cond
+	notFull  *xsync.Cond
+	deque    Deque[T]
+	mu       sync.Mutex
+	closed   bool
+}
+
+// NewConcurrent creates a new [Concurrent[T]] that holds at most capacity
+// values. If capacity is less than one, the deque is unbounded.
+func NewConcurrent[T any](capacity int) *Concurrent[T] {
+	c := &Concurrent[T]{}
+	if capacity > 0 {
+		c.deque = *NewBounded[T](capacity, DropNewest)
+	}
+	return c
+}
+
+// PushFrontContext pushes x to the front of the deque, blocking until there is
+// room to do so or ctx is done. If ctx is done first, ctx's error is returned.
+// If the deque is closed, [ErrClosed] is returned.
+func (c *Concurrent[T]) PushFrontContext(ctx context.Context, x T) error {
+	return c.push(ctx, x, c.deque.TryPushFront)
+}
+
+// PushBackContext pushes x to the back of the deque, blocking until there is
+// room to do so or ctx is done. If ctx is done first, ctx's error is returned.
+// If the deque is closed, [ErrClosed] is returned.
+func (c *Concurrent[T]) PushBackContext(ctx context.Context, x T) error {
+	return c.push(ctx, x, c.deque.TryPushBack)
+}
+
+// TryPushFront pushes x to the front of the deque without blocking, and
+// reports whether it was pushed.
+func (c *Concurrent[T]) TryPushFront(x T) bool {
+	return c.tryPush(x, c.deque.TryPushFront)
+}
+
+// TryPushBack pushes x to the back of the deque without blocking, and reports
+// whether it was pushed.
+func (c *Concurrent[T]) TryPushBack(x T) bool {
+	return c.tryPush(x, c.deque.TryPushBack)
+}
+
+// PopFrontContext pops the value off of the front of the deque and returns
+// it, blocking until there is a value or ctx is done. If ctx is done first,
+// ctx's error is returned. If the deque is closed and empty, [ErrClosed] is
+// returned.
+func (c *Concurrent[T]) PopFrontContext(ctx context.Context) (T, error) {
+	return c.pop(ctx, c.deque.MaybePopFront)
+}
+
+// PopBackContext pops the value off of the back of the deque and returns it,
+// blocking until there is a value or ctx is done. If ctx is done first, ctx's
+// error is returned. If the deque is closed and empty, [ErrClosed] is
+// returned.
+func (c *Concurrent[T]) PopBackContext(ctx context.Context) (T, error) {
+	return c.pop(ctx, c.deque.MaybePopBack)
+}
+
+// MaybePopFront pops the value off of the front of the deque without blocking
+// and returns it, if there is one. The boolean return indicates whether the T
+// is valid.
+func (c *Concurrent[T]) MaybePopFront() (T, bool) {
+	return c.tryPop(c.deque.MaybePopFront)
+}
+
+// MaybePopBack pops the value off of the back of the deque without blocking
+// and returns it, if there is one. The boolean return indicates whether the T
+// is valid.
+func (c *Concurrent[T]) MaybePopBack() (T, bool) {
+	return c.tryPop(c.deque.MaybePopBack)
+}
+
+// Len returns the number of values held by the deque.
+func (c *Concurrent[T]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.deque.Len()
+}
+
+// Cap returns the maximum number of values the deque can hold, or 0 if the
+// deque is unbounded.
+func (c *Concurrent[T]) Cap() int {
+	return c.deque.limit
+}
+
+// Close closes the deque. Subsequent pushes fail with [ErrClosed], and any
+// values remaining in the deque may still be popped. Blocked callers are
+// woken. Close is idempotent.
+func (c *Concurrent[T]) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.closed = true
+	for _, cond := range []*xsync.Cond{c.notEmpty, c.notFull} {
+		if cond != nil {
+			cond.Broadcast()
+		}
+	}
+}
+
+func (c *Concurrent[T]) push(
+	ctx context.Context,
+	x T,
+	push func(T) bool,
+) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for {
+		if c.closed {
+			return ErrClosed
+		}
+		if push(x) {
+			signal(c.notEmpty)
+			return nil
+		}
+		if err := c.waitUnsafe(ctx, &c.notFull); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *Concurrent[T]) tryPush(x T, push func(T) bool) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed || !push(x) {
+		return false
+	}
+
+	signal(c.notEmpty)
+	return true
+}
+
+func (c *Concurrent[T]) pop(
+	ctx context.Context,
+	pop func() (T, bool),
+) (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for {
+		if x, ok := pop(); ok {
+			signal(c.notFull)
+			return x, nil
+		}
+
+		var zero T
+		if c.closed {
+			return zero, ErrClosed
+		}
+		if err := c.waitUnsafe(ctx, &c.notEmpty); err != nil {
+			return zero, err
+		}
+	}
+}
+
+func (c *Concurrent[T]) tryPop(pop func() (T, bool)) (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	x, ok := pop()
+	if ok {
+		signal(c.notFull)
+	}
+	return x, ok
+}
+
+// waitUnsafe waits on *cond, creating it if necessary, until it is signaled
+// or ctx is done. c.mu must be held.
+func (c *Concurrent[T]) waitUnsafe(
+	ctx context.Context,
+	cond **xsync.Cond,
+) error {
+	if *cond == nil {
+		*cond = xsync.NewCond(&c.mu)
+	}
+	return (*cond).WaitContext(ctx)
+}
+
+// signal wakes one goroutine waiting on cond, if there is any.
+func signal(cond *xsync.Cond) {
+	if cond != nil {
+		cond.Signal()
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package deque_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"go.mway.dev/x/container/deque"
+)
+
+// A lockedDeque is the baseline that the concurrent deques are compared
+// against: a [deque.Deque] guarded by a mutex.
+type lockedDeque struct {
+	d  *deque.Deque[int]
+	mu sync.Mutex
+}
+
+func (d *lockedDeque) PushBack(x int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.d.PushBack(x)
+}
+
+func (d *lockedDeque) MaybePopBack() (int, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.d.MaybePopBack()
+}
+
+func (d *lockedDeque) MaybePopFront() (int, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.d.MaybePopFront()
+}
+
+func BenchmarkWorkStealing_Owner(b *testing.B) {
+	b.Run("mutex", func(b *testing.B) {
+		d := &lockedDeque{d: deque.New[int](64)}
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := range b.N {
+			d.PushBack(i)
+			d.MaybePopBack()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var (
+			d   = deque.NewConcurrent[int](0)
+			ctx = context.Background()
+		)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := range b.N {
+			d.PushBackContext(ctx, i) //nolint:errcheck
+			d.MaybePopBack()
+		}
+	})
+
+	b.Run("work-stealing", func(b *testing.B) {
+		d := deque.NewWorkStealing[int](64)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := range b.N {
+			d.Push(i)
+			d.Pop()
+		}
+	})
+}
+
+func BenchmarkWorkStealing_Steal(b *testing.B) {
+	b.Run("mutex", func(b *testing.B) {
+		d := &lockedDeque{d: deque.New[int](64)}
+		benchmarkSteal(b, d.PushBack, d.MaybePopBack, d.MaybePopFront)
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var (
+			d    = deque.NewConcurrent[int](0)
+			ctx  = context.Background()
+			push = func(x int) {
+				d.PushBackContext(ctx, x) //nolint:errcheck
+			}
+		)
+		benchmarkSteal(b, push, d.MaybePopBack, d.MaybePopFront)
+	})
+
+	b.Run("work-stealing", func(b *testing.B) {
+		d := deque.NewWorkStealing[int](64)
+		benchmarkSteal(b, d.Push, d.Pop, d.Steal)
+	})
+}
+
+// benchmarkSteal runs an owner that pushes b.N values and pops every other
+// one, while parallel thieves steal values until the owner is done.
+func benchmarkSteal(
+	b *testing.B,
+	push func(int),
+	pop func() (int, bool),
+	steal func() (int, bool),
+) {
+	var (
+		done = make(chan struct{})
+		wg   sync.WaitGroup
+	)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(done)
+		for i := range b.N {
+			push(i)
+			if i%2 == 0 {
+				pop()
+			}
+		}
+	}()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			select {
+			case <-done:
+			default:
+				steal()
+			}
+		}
+	})
+
+	wg.Wait()
+}
@@ -0,0 +1,241 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package deque_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/container/deque"
+)
+
+func TestConcurrent(t *testing.T) {
+	var (
+		d   deque.Concurrent[int]
+		ctx = context.Background()
+	)
+
+	require.Zero(t, d.Cap())
+	require.NoError(t, d.PushBackContext(ctx, 2))
+	require.NoError(t, d.PushFrontContext(ctx, 1))
+	require.True(t, d.TryPushBack(3))
+	require.True(t, d.TryPushFront(0))
+	require.Equal(t, 4, d.Len())
+
+	x, err := d.PopFrontContext(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, x)
+
+	x, err = d.PopBackContext(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, x)
+
+	x, ok := d.MaybePopFront()
+	require.True(t, ok)
+	require.Equal(t, 1, x)
+
+	x, ok = d.MaybePopBack()
+	require.True(t, ok)
+	require.Equal(t, 2, x)
+
+	_, ok = d.MaybePopFront()
+	require.False(t, ok)
+	_, ok = d.MaybePopBack()
+	require.False(t, ok)
+}
+
+func TestConcurrent_PopBlocks(t *testing.T) {
+	var (
+		d      = deque.NewConcurrent[int](0)
+		ctx    = context.Background()
+		popped = make(chan int)
+	)
+
+	go func() {
+		x, err := d.PopBackContext(ctx)
+		if err == nil {
+			popped <- x
+		}
+		close(popped)
+	}()
+
+	select {
+	case <-popped:
+		require.FailNow(t, "popped from an empty deque")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	require.NoError(t, d.PushFrontContext(ctx, 123))
+	require.Equal(t, 123, <-popped)
+}
+
+func TestConcurrent_PushBlocks(t *testing.T) {
+	var (
+		d      = deque.NewConcurrent[int](2)
+		ctx    = context.Background()
+		pushed = make(chan error)
+	)
+
+	require.Equal(t, 2, d.Cap())
+	require.True(t, d.TryPushBack(1))
+	require.True(t, d.TryPushBack(2))
+	require.False(t, d.TryPushBack(3))
+	require.False(t, d.TryPushFront(3))
+
+	go func() {
+		pushed <- d.PushFrontContext(ctx, 0)
+	}()
+
+	select {
+	case <-pushed:
+		require.FailNow(t, "pushed to a full deque")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	x, err := d.PopBackContext(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, x)
+	require.NoError(t, <-pushed)
+
+	x, err = d.PopFrontContext(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, x)
+}
+
+func TestConcurrent_ContextDone(t *testing.T) {
+	d := deque.NewConcurrent[int](1)
+	require.True(t, d.TryPushBack(1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, d.PushBackContext(ctx, 2), context.DeadlineExceeded)
+
+	x, err := d.PopFrontContext(ctx)
+	require.NoError(t, err, "available values are popped regardless of ctx")
+	require.Equal(t, 1, x)
+
+	_, err = d.PopFrontContext(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	_, err = d.PopBackContext(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestConcurrent_Close(t *testing.T) {
+	var (
+		d    = deque.NewConcurrent[int](1)
+		ctx  = context.Background()
+		errs = make(chan error)
+	)
+
+	go func() {
+		_, err := d.PopFrontContext(ctx)
+		errs <- err
+	}()
+
+	d.Close()
+	require.ErrorIs(t, <-errs, deque.ErrClosed)
+
+	d = deque.NewConcurrent[int](1)
+	require.True(t, d.TryPushBack(1))
+
+	go func() {
+		errs <- d.PushBackContext(ctx, 2)
+	}()
+
+	d.Close()
+	d.Close()
+	require.ErrorIs(t, <-errs, deque.ErrClosed)
+
+	require.False(t, d.TryPushBack(2))
+	require.ErrorIs(t, d.PushFrontContext(ctx, 2), deque.ErrClosed)
+
+	x, err := d.PopBackContext(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, x)
+
+	_, err = d.PopBackContext(ctx)
+	require.ErrorIs(t, err, deque.ErrClosed)
+}
+
+func TestConcurrent_ProducersConsumers(t *testing.T) {
+	const (
+		producers = 4
+		consumers = 4
+		count     = 1000
+	)
+
+	var (
+		d           = deque.NewConcurrent[int](8)
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+		sums        = make(chan int, consumers)
+		wg          sync.WaitGroup
+	)
+	defer cancel()
+
+	for p := range producers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range count {
+				push := d.PushBackContext
+				if (p+i)%2 == 0 {
+					push = d.PushFrontContext
+				}
+				if err := push(ctx, i); err != nil {
+					return
+				}
+			}
+		}()
+	}
+
+	for c := range consumers {
+		go func() {
+			var sum int
+			defer func() { sums <- sum }()
+
+			pop := d.PopFrontContext
+			if c%2 == 0 {
+				pop = d.PopBackContext
+			}
+			for {
+				x, err := pop(ctx)
+				if err != nil {
+					return
+				}
+				sum += x
+			}
+		}()
+	}
+
+	wg.Wait()
+	d.Close()
+
+	var sum int
+	for range consumers {
+		sum += <-sums
+	}
+	require.NoError(t, ctx.Err())
+	require.Equal(t, producers*count*(count-1)/2, sum)
+}
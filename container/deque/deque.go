@@ -22,6 +22,7 @@
 package deque
 
 import (
+	"iter"
 	"slices"
 
 	"go.mway.dev/pool"
@@ -29,15 +30,70 @@ import (
 	"go.mway.dev/x/container/list"
 )
 
+// _minCapacity is the minimum capacity that an unbounded [Deque] grows to.
+const _minCapacity = 4
+
+// A Policy determines what happens when a value is pushed to a full bounded
+// buffer.
+type Policy int
+
+const (
+	// DropNewest drops the pushed value.
+	DropNewest Policy = iota
+	// DropOldest evicts the value at the opposite end of the buffer to make
+	// room for the pushed value.
+	DropOldest
+	// Block waits until there is room for the pushed value. A [Deque] cannot
+	// wait, so [NewBounded] panics if given Block; use [Concurrent] instead.
+	Block
+)
+
+// Interface is the set of methods shared by [Deque] and [LinkedDeque], so that
+// callers may use either implementation interchangeably.
+type Interface[T any] interface {
+	PushFront(x T)
+	PushBack(x T)
+	Front() T
+	Back() T
+	MaybeFront() (T, bool)
+	MaybeBack() (T, bool)
+	PopFront() T
+	PopBack() T
+	MaybePopFront() (T, bool)
+	MaybePopBack() (T, bool)
+	PeekEachFront(fn func(T) bool)
+	PeekEachBack(fn func(T) bool)
+	PopEachFront(fn func(T) bool)
+	PopEachBack(fn func(T) bool)
+	All() iter.Seq2[int, T]
+	Values() iter.Seq[T]
+	Backward() iter.Seq2[int, T]
+	Drain() iter.Seq[T]
+	ToSlice() []T
+	Rotate(n int)
+	Len() int
+}
+
+var (
+	_ Interface[int] = (*Deque[int])(nil)
+	_ Interface[int] = (*LinkedDeque[int])(nil)
+)
+
 // A Deque is a double-ended (FIFO and LIFO) queue that holds values of type T.
+// It is backed by a ring buffer that grows as needed, unless it was created
+// with [NewBounded].
 type Deque[T any] struct {
-	data []T
+	data   []T
+	head   int
+	len    int
+	limit  int
+	policy Policy
 }
 
 // New creates a new [Deque[T]] with the given initial capacity.
 func New[T any](size int) *Deque[T] {
 	return &Deque[T]{
-		data: make([]T, 0, size),
+		data: make([]T, max(size, 0)),
 	}
 }
 
@@ -45,14 +101,51 @@ func New[T any](size int) *Deque[T] {
 func NewWithValues[T any](values ...T) *Deque[T] {
 	return &Deque[T]{
 		data: slices.Clone(values),
+		len:  len(values),
 	}
 }
 
-// PushFront pushes x to the front of the deque.
+// FromSeq creates a new [Deque[T]] that holds each value yielded by seq, in
+// order.
+func FromSeq[T any](seq iter.Seq[T]) *Deque[T] {
+	return NewWithValues(slices.Collect(seq)...)
+}
+
+// NewBounded creates a new [Deque[T]] that holds at most capacity values,
+// applying policy when a value is pushed while it is full. NewBounded panics
+// if capacity is less than one or if policy is [Block].
+func NewBounded[T any](capacity int, policy Policy) *Deque[T] {
+	switch {
+	case capacity < 1:
+		panic("deque: capacity must be at least one")
+	case policy == Block:
+		panic("deque: a Deque cannot block; use Concurrent instead")
+	}
+
+	return &Deque[T]{
+		data:   make([]T, capacity),
+		limit:  capacity,
+		policy: policy,
+	}
+}
+
+// PushFront pushes x to the front of the deque. If the deque is bounded and
+// full, x is handled according to the deque's [Policy].
 func (d *Deque[T]) PushFront(x T) {
-	d.data = append(d.data, x)
-	copy(d.data[1:], d.data)
-	d.data[0] = x
+	d.TryPushFront(x)
+}
+
+// TryPushFront pushes x to the front of the deque, and reports whether it was
+// pushed. It only returns false for bounded deques that use [DropNewest].
+func (d *Deque[T]) TryPushFront(x T) bool {
+	if !d.reserve(false /* evictFront */) {
+		return false
+	}
+
+	d.head = d.index(-1)
+	d.data[d.head] = x
+	d.len++
+	return true
 }
 
 // Front returns the value at the front of the deque.
@@ -64,11 +157,11 @@ func (d *Deque[T]) Front() T {
 // MaybeFront returns the value at the front of the deque if there is one. The
 // boolean return indicates whether the T value is valid.
 func (d *Deque[T]) MaybeFront() (T, bool) {
-	if len(d.data) == 0 {
+	if d.len == 0 {
 		var zero T
 		return zero, false
 	}
-	return d.data[0], true
+	return d.data[d.head], true
 }
 
 // PopFront pops the value off of the front of the deque and returns it.
@@ -80,13 +173,15 @@ func (d *Deque[T]) PopFront() T {
 // MaybePopFront pops the value off of the front of the deque and returns it,
 // if there is one. The boolean return indicates whether the T is valid.
 func (d *Deque[T]) MaybePopFront() (T, bool) {
-	if len(d.data) == 0 {
-		var zero T
+	var zero T
+	if d.len == 0 {
 		return zero, false
 	}
 
-	x := d.data[0]
-	d.data = d.data[1:]
+	x := d.data[d.head]
+	d.data[d.head] = zero
+	d.head = d.index(1)
+	d.len--
 	return x, true
 }
 
@@ -95,12 +190,8 @@ func (d *Deque[T]) MaybePopFront() (T, bool) {
 // control will return to the caller immediately. If there are no values in the
 // deque, fn will not be called.
 func (d *Deque[T]) PeekEachFront(fn func(T) bool) {
-	if len(d.data) == 0 {
-		return
-	}
-
-	for i := 0; i < len(d.data); i++ {
-		if !fn(d.data[i]) {
+	for _, x := range d.All() {
+		if !fn(x) {
 			return
 		}
 	}
@@ -118,9 +209,22 @@ func (d *Deque[T]) PopEachFront(fn func(T) bool) {
 	}
 }
 
-// PushBack pushes x to the back of the deque.
+// PushBack pushes x to the back of the deque. If the deque is bounded and
+// full, x is handled according to the deque's [Policy].
 func (d *Deque[T]) PushBack(x T) {
-	d.data = append(d.data, x)
+	d.TryPushBack(x)
+}
+
+// TryPushBack pushes x to the back of the deque, and reports whether it was
+// pushed. It only returns false for bounded deques that use [DropNewest].
+func (d *Deque[T]) TryPushBack(x T) bool {
+	if !d.reserve(true /* evictFront */) {
+		return false
+	}
+
+	d.data[d.index(d.len)] = x
+	d.len++
+	return true
 }
 
 // Back returns the value at the back of the deque.
@@ -132,12 +236,11 @@ func (d *Deque[T]) Back() T {
 // MaybeBack returns the value at the back of the deque if there is one. The
 // boolean return indicates whether the T value is valid.
 func (d *Deque[T]) MaybeBack() (T, bool) {
-	if len(d.data) == 0 {
+	if d.len == 0 {
 		var zero T
 		return zero, false
 	}
-
-	return d.data[len(d.data)-1], true
+	return d.data[d.index(d.len-1)], true
 }
 
 // PopBack pops the back value off of the deque and returns it.
@@ -149,16 +252,15 @@ func (d *Deque[T]) PopBack() T {
 // MaybePopBack pops the top value off of the front of the deque and returns
 // it, if there is one. The boolean return indicates whether the T is valid.
 func (d *Deque[T]) MaybePopBack() (T, bool) {
-	if len(d.data) == 0 {
-		var zero T
+	var zero T
+	if d.len == 0 {
 		return zero, false
 	}
 
-	var (
-		n = len(d.data) - 1
-		x = d.data[n]
-	)
-	d.data = d.data[:n]
+	i := d.index(d.len - 1)
+	x := d.data[i]
+	d.data[i] = zero
+	d.len--
 	return x, true
 }
 
@@ -167,12 +269,8 @@ func (d *Deque[T]) MaybePopBack() (T, bool) {
 // will return to the caller immediately. If there are no values in the deque,
 // fn will not be called.
 func (d *Deque[T]) PeekEachBack(fn func(T) bool) {
-	if len(d.data) == 0 {
-		return
-	}
-
-	for i := len(d.data) - 1; i >= 0; i-- {
-		if !fn(d.data[i]) {
+	for _, x := range d.Backward() {
+		if !fn(x) {
 			return
 		}
 	}
@@ -190,11 +288,229 @@ func (d *Deque[T]) PopEachBack(fn func(T) bool) {
 	}
 }
 
+// At returns the value at index i, where index 0 is the front of the deque.
+// At panics if i is out of range.
+func (d *Deque[T]) At(i int) T {
+	d.checkIndex(i)
+	return d.data[d.index(i)]
+}
+
+// Set sets the value at index i, where index 0 is the front of the deque. Set
+// panics if i is out of range.
+func (d *Deque[T]) Set(i int, x T) {
+	d.checkIndex(i)
+	d.data[d.index(i)] = x
+}
+
+// All returns an iterator over the indexes and values in the deque, from the
+// front of the deque to the back.
+func (d *Deque[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		front, back := d.view()
+		for i, x := range front {
+			if !yield(i, x) {
+				return
+			}
+		}
+		for i, x := range back {
+			if !yield(len(front)+i, x) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the indexes and values in the deque, from
+// the back of the deque to the front.
+func (d *Deque[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		front, back := d.view()
+		for i, x := range slices.Backward(back) {
+			if !yield(len(front)+i, x) {
+				return
+			}
+		}
+		for i, x := range slices.Backward(front) {
+			if !yield(i, x) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values in the deque, from the front of
+// the deque to the back.
+func (d *Deque[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, x := range d.All() {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// Drain returns an iterator that pops and yields each value in the deque,
+// working from the front of the deque to the back. Values pushed while
+// draining are also yielded.
+func (d *Deque[T]) Drain() iter.Seq[T] {
+	return d.PopEachFront
+}
+
+// ToSlice returns a new slice that holds the values in the deque, from the
+// front of the deque to the back.
+func (d *Deque[T]) ToSlice() []T {
+	front, back := d.view()
+	return slices.Concat(front, back)
+}
+
+// Rotate rotates the deque n steps towards the back, such that the value at
+// index 0 moves to index n, and values rotated off of the back of the deque
+// wrap around to its front. If n is negative, the deque is instead rotated
+// towards the front.
+func (d *Deque[T]) Rotate(n int) {
+	if d.len == 0 {
+		return
+	}
+	if n %= d.len; n < 0 {
+		n += d.len
+	}
+
+	switch {
+	case n == 0:
+	case d.len == len(d.data):
+		// n.b. When the buffer is full, rotating only moves the head.
+		d.head = d.index(d.len - n)
+	case n <= d.len/2:
+		for range n {
+			x, _ := d.MaybePopBack()
+			d.head = d.index(-1)
+			d.data[d.head] = x
+			d.len++
+		}
+	default:
+		for range d.len - n {
+			x, _ := d.MaybePopFront()
+			d.data[d.index(d.len)] = x
+			d.len++
+		}
+	}
+}
+
+// Clear removes all values from the deque.
+func (d *Deque[T]) Clear() {
+	clear(d.data)
+	d.head = 0
+	d.len = 0
+}
+
+// Grow increases the deque's capacity, if necessary, to guarantee space for
+// another n values. For bounded deques, this raises the bound. Grow panics if
+// n is negative.
+func (d *Deque[T]) Grow(n int) {
+	if n < 0 {
+		panic("deque: cannot grow by a negative count")
+	}
+
+	if len(d.data)-d.len >= n {
+		return
+	}
+
+	d.resize(d.len + n)
+	if d.limit > 0 {
+		d.limit = len(d.data)
+	}
+}
+
+// Shrink reduces the deque's capacity to the larger of capacity and the
+// number of values held, releasing unused memory. For bounded deques, this
+// lowers the bound.
+func (d *Deque[T]) Shrink(capacity int) {
+	capacity = max(capacity, d.len)
+	if d.limit > 0 {
+		capacity = max(capacity, 1)
+	}
+	if capacity >= len(d.data) {
+		return
+	}
+
+	d.resize(capacity)
+	if d.limit > 0 {
+		d.limit = capacity
+	}
+}
+
 // Len returns the number of values held by the deque.
 func (d *Deque[T]) Len() int {
+	return d.len
+}
+
+// Cap returns the number of values the deque can hold without growing. For
+// bounded deques, this is the bound.
+func (d *Deque[T]) Cap() int {
 	return len(d.data)
 }
 
+// index returns the position in d.data of the value at index i, where i is
+// in [-1, len(d.data)].
+func (d *Deque[T]) index(i int) int {
+	i += d.head
+	if n := len(d.data); i >= n {
+		i -= n
+	} else if i < 0 {
+		i += n
+	}
+	return i
+}
+
+func (d *Deque[T]) checkIndex(i int) {
+	if i < 0 || i >= d.len {
+		panic("deque: index out of range")
+	}
+}
+
+// reserve ensures that there is room to push a value, growing the deque or
+// applying its policy as necessary. It reports whether there is room.
+func (d *Deque[T]) reserve(evictFront bool) bool {
+	if d.limit == 0 {
+		if d.len == len(d.data) {
+			d.resize(max(2*len(d.data), _minCapacity))
+		}
+		return true
+	}
+
+	switch {
+	case d.len < d.limit:
+	case d.policy == DropNewest:
+		return false
+	case evictFront:
+		d.MaybePopFront()
+	default:
+		d.MaybePopBack()
+	}
+
+	return true
+}
+
+func (d *Deque[T]) resize(capacity int) {
+	data := make([]T, capacity)
+	front, back := d.view()
+	n := copy(data, front)
+	copy(data[n:], back)
+
+	d.data = data
+	d.head = 0
+}
+
+// view returns the deque's values, in order, as two contiguous slices.
+func (d *Deque[T]) view() ([]T, []T) {
+	end := d.head + d.len
+	if end <= len(d.data) {
+		return d.data[d.head:end], nil
+	}
+	return d.data[d.head:], d.data[:end-len(d.data)]
+}
+
 // A LinkedDeque is a double-ended (FIFO and LIFO) queue that holds values of
 // type T.
 type LinkedDeque[T any] struct {
@@ -230,12 +546,20 @@ func NewLinkedWithValues[T any](values ...T) *LinkedDeque[T] {
 		return nil
 	}
 
-	head, tail := list.LinkDoublyWithTail(values[0], values[1:]...)
-	return &LinkedDeque[T]{
-		head: head,
-		tail: tail,
-		len:  len(values),
+	d := NewLinked[T]()
+	d.head, d.tail = list.LinkDoublyWithTail(values[0], values[1:]...)
+	d.len = len(values)
+	return d
+}
+
+// LinkedFromSeq creates a new [LinkedDeque[T]] that holds each value yielded
+// by seq, in order.
+func LinkedFromSeq[T any](seq iter.Seq[T]) *LinkedDeque[T] {
+	d := NewLinked[T]()
+	for x := range seq {
+		d.PushBack(x)
 	}
+	return d
 }
 
 // PushFront pushes x to the front of the deque.
@@ -404,6 +728,88 @@ func (d *LinkedDeque[T]) PopEachBack(fn func(T) bool) {
 	}
 }
 
+// All returns an iterator over the indexes and values in the deque, from the
+// front of the deque to the back.
+func (d *LinkedDeque[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, cur := 0, d.head; cur != nil; i, cur = i+1, cur.Next {
+			if !yield(i, cur.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values in the deque, from the front of
+// the deque to the back.
+func (d *LinkedDeque[T]) Values() iter.Seq[T] {
+	return d.PeekEachFront
+}
+
+// Backward returns an iterator over the indexes and values in the deque, from
+// the back of the deque to the front.
+func (d *LinkedDeque[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, cur := d.len-1, d.tail; cur != nil; i, cur = i-1, cur.Prev {
+			if !yield(i, cur.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Drain returns an iterator that pops and yields each value in the deque,
+// working from the front of the deque to the back. Values pushed while
+// draining are also yielded.
+func (d *LinkedDeque[T]) Drain() iter.Seq[T] {
+	return d.PopEachFront
+}
+
+// ToSlice returns a new slice that holds the values in the deque, from the
+// front of the deque to the back.
+func (d *LinkedDeque[T]) ToSlice() []T {
+	values := make([]T, 0, d.len)
+	for cur := d.head; cur != nil; cur = cur.Next {
+		values = append(values, cur.Value())
+	}
+	return values
+}
+
+// Rotate rotates the deque n steps towards the back, such that the value at
+// index 0 moves to index n, and values rotated off of the back of the deque
+// wrap around to its front. If n is negative, the deque is instead rotated
+// towards the front.
+func (d *LinkedDeque[T]) Rotate(n int) {
+	if d.len == 0 {
+		return
+	}
+	if n %= d.len; n < 0 {
+		n += d.len
+	}
+	if n == 0 {
+		return
+	}
+
+	// Find the node that will become the new tail, walking from whichever end
+	// of the deque is closer.
+	tail := d.tail
+	if n <= d.len/2 {
+		for range n {
+			tail = tail.Prev
+		}
+	} else {
+		tail = d.head
+		for range d.len - n - 1 {
+			tail = tail.Next
+		}
+	}
+
+	// Close the list into a ring, then break it after the new tail.
+	d.tail.Next, d.head.Prev = d.head, d.tail
+	d.head, d.tail = tail.Next, tail
+	d.head.Prev, d.tail.Next = nil, nil
+}
+
 // Len returns the number of values held by the deque.
 func (d *LinkedDeque[T]) Len() int {
 	return d.len
@@ -0,0 +1,157 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package deque_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/container/deque"
+)
+
+func TestInterface_Iterators(t *testing.T) {
+	for name, newDeque := range newDeques() {
+		t.Run(name, func(t *testing.T) {
+			d := newDeque(1, 2, 3, 4, 5)
+
+			var indexes []int
+			for i := range d.All() {
+				indexes = append(indexes, i)
+			}
+			require.Equal(t, []int{0, 1, 2, 3, 4}, indexes)
+			require.Equal(t, []int{1, 2, 3, 4, 5}, values(d.All()))
+			require.Equal(t, []int{1, 2, 3, 4, 5}, slices.Collect(d.Values()))
+			require.Equal(t, []int{1, 2, 3, 4, 5}, d.ToSlice())
+
+			indexes = indexes[:0]
+			for i := range d.Backward() {
+				indexes = append(indexes, i)
+			}
+			require.Equal(t, []int{4, 3, 2, 1, 0}, indexes)
+			require.Equal(t, []int{5, 4, 3, 2, 1}, values(d.Backward()))
+
+			for range d.All() {
+				break
+			}
+			for range d.Values() {
+				break
+			}
+			for range d.Backward() {
+				break
+			}
+			require.Equal(t, 5, d.Len())
+
+			for x := range d.Drain() {
+				if x == 2 {
+					break
+				}
+			}
+			require.Equal(t, []int{3, 4, 5}, d.ToSlice())
+			require.Equal(t, []int{3, 4, 5}, slices.Collect(d.Drain()))
+			require.Zero(t, d.Len())
+			require.Empty(t, d.ToSlice())
+		})
+	}
+}
+
+func TestInterface_Rotate(t *testing.T) {
+	for name, newDeque := range newDeques() {
+		t.Run(name, func(t *testing.T) {
+			d := newDeque(0)
+			d.PopFront()
+			d.Rotate(3)
+			require.Zero(t, d.Len())
+
+			for _, n := range []int{0, 1, 2, 3, 4, 5, 6, 7, 11, -1, -3, -7} {
+				d = newDeque(0, 1, 2, 3, 4)
+				d.Rotate(n)
+
+				want := []int{0, 1, 2, 3, 4}
+				k := ((n % len(want)) + len(want)) % len(want)
+				want = append(want[len(want)-k:], want[:len(want)-k]...)
+
+				require.Equal(t, want, d.ToSlice(), "n=%d", n)
+				rev := slices.Clone(want)
+				slices.Reverse(rev)
+				require.Equal(t, rev, values(d.Backward()), "n=%d", n)
+				require.Equal(t, want[0], d.Front(), "n=%d", n)
+				require.Equal(t, want[4], d.Back(), "n=%d", n)
+			}
+		})
+	}
+}
+
+func TestDeque_RotateWrapped(t *testing.T) {
+	// Rotate a deque whose values wrap around the end of its buffer, both
+	// with and without spare capacity.
+	for _, size := range []int{5, 8} {
+		d := deque.New[int](size)
+		for i := range 3 {
+			d.PushBack(i + 2)
+		}
+		d.PushFront(1)
+		d.PushFront(0)
+		require.Equal(t, size, d.Cap())
+
+		for n := range 12 {
+			d.Rotate(1)
+			want := []int{0, 1, 2, 3, 4}
+			k := (n + 1) % len(want)
+			want = append(want[len(want)-k:], want[:len(want)-k]...)
+			require.Equal(t, want, d.ToSlice(), "size=%d n=%d", size, n)
+		}
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	seq := slices.Values([]int{1, 2, 3})
+	require.Equal(t, []int{1, 2, 3}, deque.FromSeq(seq).ToSlice())
+	require.Equal(t, []int{1, 2, 3}, deque.LinkedFromSeq(seq).ToSlice())
+	require.Zero(t, deque.FromSeq(slices.Values([]int(nil))).Len())
+	require.Zero(t, deque.LinkedFromSeq(slices.Values([]int(nil))).Len())
+}
+
+func TestNewLinkedWithValues_Push(t *testing.T) {
+	d := deque.NewLinkedWithValues(2, 3)
+	d.PushFront(1)
+	d.PushBack(4)
+	require.Equal(t, []int{1, 2, 3, 4}, d.ToSlice())
+}
+
+func newDeques() map[string]func(...int) deque.Interface[int] {
+	return map[string]func(...int) deque.Interface[int]{
+		"Deque": func(values ...int) deque.Interface[int] {
+			return deque.NewWithValues(values...)
+		},
+		"LinkedDeque": func(values ...int) deque.Interface[int] {
+			return deque.NewLinkedWithValues(values...)
+		},
+		"bounded": func(values ...int) deque.Interface[int] {
+			d := deque.NewBounded[int](len(values), deque.DropNewest)
+			for _, x := range values {
+				d.PushBack(x)
+			}
+			return d
+		},
+	}
+}
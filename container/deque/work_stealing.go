@@ -0,0 +1,168 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package deque
+
+import (
+	"sync/atomic"
+)
+
+// _minWorkStealingCapacity is the minimum capacity of a [WorkStealing]'s
+// buffer.
+const _minWorkStealingCapacity = 16
+
+// A WorkStealing is a lock-free, unbounded work-stealing deque, as described
+// by Chase and Lev ("Dynamic Circular Work-Stealing Deque", 2005). A single
+// owner goroutine pushes and pops values at the back of the deque, while any
+// number of thief goroutines steal values from the front.
+//
+// [WorkStealing.Push] and [WorkStealing.Pop] must only be called by the owner;
+// [WorkStealing.Steal] and [WorkStealing.Len] may be called by any goroutine.
+type WorkStealing[T any] struct {
+	buffer atomic.Pointer[stealBuffer[T]]
+	top    atomic.Int64
+	bottom atomic.Int64
+}
+
+// NewWorkStealing creates a new [WorkStealing[T]] with the given initial
+// capacity.
+func NewWorkStealing[T any](size int) *WorkStealing[T] {
+	var (
+		d        = &WorkStealing[T]{}
+		capacity = _minWorkStealingCapacity
+	)
+	for capacity < size {
+		capacity <<= 1
+	}
+	d.buffer.Store(newStealBuffer[T](capacity))
+	return d
+}
+
+// Push pushes x to the back of the deque, growing it if necessary. Push must
+// only be called by the deque's owner.
+func (d *WorkStealing[T]) Push(x T) {
+	var (
+		b   = d.bottom.Load()
+		t   = d.top.Load()
+		buf = d.buffer.Load()
+	)
+	if b-t >= int64(len(buf.slots)) {
+		buf = buf.grow(t, b)
+		d.buffer.Store(buf)
+	}
+
+	buf.slot(b).Store(&x)
+	d.bottom.Store(b + 1)
+}
+
+// Pop pops the value off of the back of the deque and returns it, if there is
+// one. The boolean return indicates whether the T is valid. Pop must only be
+// called by the deque's owner.
+func (d *WorkStealing[T]) Pop() (T, bool) {
+	var (
+		zero T
+		b    = d.bottom.Load() - 1
+		buf  = d.buffer.Load()
+	)
+
+	// Reserve the back value before checking for thieves, so that any thief
+	// that has not yet observed the new bottom must race the owner for it.
+	d.bottom.Store(b)
+
+	t := d.top.Load()
+	if t > b {
+		d.bottom.Store(b + 1)
+		return zero, false
+	}
+
+	slot := buf.slot(b)
+	x := slot.Load()
+	if t == b {
+		// The last value may also be claimed by a thief, so the owner must
+		// claim it the same way.
+		won := d.top.CompareAndSwap(t, t+1)
+		d.bottom.Store(b + 1)
+		if !won {
+			return zero, false
+		}
+	}
+
+	// n.b. Only the owner clears slots, and only once no thief can claim
+	// them. Stolen values are retained until their slots are reused.
+	slot.Store(nil)
+	return *x, true
+}
+
+// Steal pops the value off of the front of the deque and returns it, if there
+// is one. The boolean return indicates whether the T is valid. Steal may be
+// called by any goroutine; it only fails if the deque is observed to be empty.
+func (d *WorkStealing[T]) Steal() (T, bool) {
+	for {
+		var (
+			t = d.top.Load()
+			b = d.bottom.Load()
+		)
+		if t >= b {
+			var zero T
+			return zero, false
+		}
+
+		// n.b. The slot is loaded before claiming it: once top advances, the
+		// owner may reuse the slot. If the claim fails, another goroutine took
+		// the value, and the load is discarded.
+		x := d.buffer.Load().slot(t).Load()
+		if d.top.CompareAndSwap(t, t+1) {
+			return *x, true
+		}
+	}
+}
+
+// Len returns the number of values held by the deque. If the deque is in use
+// by other goroutines, the result may be stale by the time it is returned.
+func (d *WorkStealing[T]) Len() int {
+	return int(max(d.bottom.Load()-d.top.Load(), 0))
+}
+
+// A stealBuffer is the circular array that backs a [WorkStealing]. Its length
+// is always a power of two. Values are stored by pointer so that owners and
+// thieves can access slots atomically.
+type stealBuffer[T any] struct {
+	slots []atomic.Pointer[T]
+}
+
+func newStealBuffer[T any](capacity int) *stealBuffer[T] {
+	return &stealBuffer[T]{
+		slots: make([]atomic.Pointer[T], capacity),
+	}
+}
+
+func (b *stealBuffer[T]) slot(i int64) *atomic.Pointer[T] {
+	return &b.slots[i&int64(len(b.slots)-1)]
+}
+
+// grow returns a copy of b with twice the capacity, holding the values in
+// [top, bottom). Thieves still using b may continue to read from it.
+func (b *stealBuffer[T]) grow(top, bottom int64) *stealBuffer[T] {
+	buf := newStealBuffer[T](2 * len(b.slots))
+	for i := top; i < bottom; i++ {
+		buf.slot(i).Store(b.slot(i).Load())
+	}
+	return buf
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package deque_test
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/container/deque"
+)
+
+func TestWorkStealing(t *testing.T) {
+	d := deque.NewWorkStealing[int](0)
+
+	_, ok := d.Pop()
+	require.False(t, ok)
+	_, ok = d.Steal()
+	require.False(t, ok)
+
+	// Push enough values to grow the deque several times.
+	for i := range 100 {
+		d.Push(i)
+	}
+	require.Equal(t, 100, d.Len())
+
+	for i := range 10 {
+		x, ok := d.Steal()
+		require.True(t, ok)
+		require.Equal(t, i, x)
+	}
+	for i := 99; i >= 10; i-- {
+		x, ok := d.Pop()
+		require.True(t, ok)
+		require.Equal(t, i, x)
+	}
+	require.Zero(t, d.Len())
+
+	_, ok = d.Pop()
+	require.False(t, ok)
+	_, ok = d.Steal()
+	require.False(t, ok)
+
+	// Wrap around the buffer without growing it.
+	d = deque.NewWorkStealing[int](32)
+	for i := range 1000 {
+		d.Push(i)
+		x, ok := d.Steal()
+		require.True(t, ok)
+		require.Equal(t, i, x)
+	}
+}
+
+func TestWorkStealing_Concurrent(t *testing.T) {
+	const (
+		count   = 10_000
+		thieves = 4
+	)
+
+	var (
+		d      = deque.NewWorkStealing[int](0)
+		seen   = make([]atomic.Int32, count)
+		done   atomic.Bool
+		wg     sync.WaitGroup
+		record = func(x int) { seen[x].Add(1) }
+	)
+
+	for range thieves {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				if x, ok := d.Steal(); ok {
+					record(x)
+					continue
+				}
+				if done.Load() {
+					return
+				}
+				runtime.Gosched()
+			}
+		}()
+	}
+
+	// The owner interleaves pushes and pops, so that it races thieves for the
+	// last value in the deque.
+	for i := range count {
+		d.Push(i)
+		if i%3 == 0 {
+			if x, ok := d.Pop(); ok {
+				record(x)
+			}
+		}
+	}
+	for {
+		x, ok := d.Pop()
+		if !ok {
+			break
+		}
+		record(x)
+	}
+
+	done.Store(true)
+	wg.Wait()
+
+	require.Zero(t, d.Len())
+	for i := range seen {
+		require.EqualValues(t, 1, seen[i].Load(), "value %d", i)
+	}
+}
@@ -23,6 +23,7 @@ package heap
 
 import (
 	"cmp"
+	"iter"
 	"slices"
 )
 
@@ -31,13 +32,13 @@ import (
 
 // MinHeap is a min heap (P<=C).
 type MinHeap[T cmp.Ordered] struct {
-	heap[T, heapTypeMin]
+	heap[T, heapTypeMin[T]]
 }
 
 // NewMinHeap creates a new [MinHeap] with the given initial values.
 func NewMinHeap[T cmp.Ordered](values ...T) *MinHeap[T] {
 	return &MinHeap[T]{
-		heap: newHeap[T, heapTypeMin](values...),
+		heap: newHeap(heapTypeMin[T]{}, values...),
 	}
 }
 
@@ -48,13 +49,13 @@ func (h *MinHeap[T]) Min() T {
 
 // MaxHeap is a max heap (P>=C).
 type MaxHeap[T cmp.Ordered] struct {
-	heap[T, heapTypeMax]
+	heap[T, heapTypeMax[T]]
 }
 
 // NewMaxHeap creates a new [MaxHeap] with the given initial values.
 func NewMaxHeap[T cmp.Ordered](values ...T) *MaxHeap[T] {
 	return &MaxHeap[T]{
-		heap: newHeap[T, heapTypeMax](values...),
+		heap: newHeap(heapTypeMax[T]{}, values...),
 	}
 }
 
@@ -63,24 +64,59 @@ func (h *MaxHeap[T]) Max() T {
 	return h.top()
 }
 
+// Heap is a heap of arbitrary values, ordered by a less function: for each
+// parent P and child C, less(C, P) is false.
+type Heap[T any] struct {
+	heap[T, heapTypeFunc[T]]
+}
+
+// New creates a new [Heap] ordered by less, with the given initial values.
+// less reports whether a must be popped before b.
+func New[T any](less func(a T, b T) bool, values ...T) *Heap[T] {
+	return &Heap[T]{
+		heap: newHeap(heapTypeFunc[T](less), values...),
+	}
+}
+
+// Peek returns the value at the top of the heap, which is the next value that
+// will be popped.
+func (h *Heap[T]) Peek() T {
+	return h.top()
+}
+
 // Types used to do static type switching to disambiguate comparison while
 // sharing logic.
 type (
-	heapTypeMin struct{}
-	heapTypeMax struct{}
+	heapTypeMin[T cmp.Ordered] struct{}
+	heapTypeMax[T cmp.Ordered] struct{}
+	heapTypeFunc[T any]        func(a T, b T) bool
 )
 
-type heapType interface {
-	heapTypeMin | heapTypeMax
+type heapType[T any] interface {
+	less(a T, b T) bool
+}
+
+func (heapTypeMin[T]) less(a T, b T) bool {
+	return a < b
 }
 
-type heap[T cmp.Ordered, H heapType] struct {
+func (heapTypeMax[T]) less(a T, b T) bool {
+	return a > b
+}
+
+func (f heapTypeFunc[T]) less(a T, b T) bool {
+	return f(a, b)
+}
+
+type heap[T any, H heapType[T]] struct {
 	data []T
+	typ  H
 }
 
-func newHeap[T cmp.Ordered, H heapType](values ...T) heap[T, H] {
+func newHeap[T any, H heapType[T]](typ H, values ...T) heap[T, H] {
 	h := heap[T, H]{
 		data: slices.Clone(values),
+		typ:  typ,
 	}
 	h.init()
 	return h
@@ -105,11 +141,7 @@ func (h *heap[T, H]) Len() int {
 }
 
 func (h *heap[T, H]) Less(i int, j int) bool {
-	var zero H
-	if _, ok := any(zero).(heapTypeMin); ok {
-		return h.data[i] < h.data[j]
-	}
-	return h.data[i] > h.data[j]
+	return h.typ.less(h.data[i], h.data[j])
 }
 
 func (h *heap[T, H]) Swap(i int, j int) {
@@ -129,6 +161,20 @@ func (h *heap[T, H]) Remove(i int) T {
 	return x
 }
 
+// Fix re-establishes the heap ordering after the value at index i has
+// changed, such as when T is a pointer whose referent was modified.
+func (h *heap[T, H]) Fix(i int) {
+	if !h.down(i, h.Len()) {
+		h.up(i)
+	}
+}
+
+// All returns an iterator over the indexes and values on the heap, in heap
+// order. Indexes may be passed to Fix and Remove.
+func (h *heap[T, H]) All() iter.Seq2[int, T] {
+	return slices.All(h.data)
+}
+
 func (h *heap[T, H]) Reset() {
 	h.data = h.data[:0]
 }
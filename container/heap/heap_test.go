@@ -96,6 +96,56 @@ func TestHeap(t *testing.T) {
 	require.Equal(t, 0, maxh.Len())
 }
 
+func TestNew(t *testing.T) {
+	type job struct {
+		name     string
+		deadline int
+	}
+
+	byDeadline := func(a *job, b *job) bool {
+		return a.deadline < b.deadline
+	}
+
+	var (
+		a = &job{name: "a", deadline: 3}
+		b = &job{name: "b", deadline: 1}
+		c = &job{name: "c", deadline: 2}
+		d = &job{name: "d", deadline: 5}
+		h = heap.New(byDeadline, a, b, c)
+	)
+
+	require.Equal(t, 3, h.Len())
+	require.Same(t, b, h.Peek())
+
+	h.Push(d)
+	require.Equal(t, 4, h.Len())
+	require.Same(t, b, h.Peek())
+
+	// Move d to the front of the line, then fix its position.
+	d.deadline = 0
+	for i, x := range h.All() {
+		if x == d {
+			h.Fix(i)
+			break
+		}
+	}
+	require.Same(t, d, h.Peek())
+
+	// Remove c from wherever it is.
+	for i, x := range h.All() {
+		if x == c {
+			require.Same(t, c, h.Remove(i))
+			break
+		}
+	}
+
+	require.Same(t, d, h.Pop())
+	require.Same(t, b, h.Pop())
+	require.Same(t, a, h.Pop())
+	require.Zero(t, h.Len())
+	require.Nil(t, h.Peek())
+}
+
 func BenchmarkMinHeap_PushPop(b *testing.B) {
 	var h heap.MinHeap[int]
 
@@ -0,0 +1,122 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package heap
+
+import (
+	"iter"
+)
+
+// TopK returns the k values yielded by seq that are ordered first by less, in
+// that order. If seq yields fewer than k values, all of them are returned.
+func TopK[T any](seq iter.Seq[T], k int, less func(a T, b T) bool) []T {
+	if k <= 0 {
+		return nil
+	}
+
+	// Hold the best values seen so far in a heap with the reverse ordering,
+	// so that the worst of them is on top and can be replaced.
+	h := newHeap(heapTypeFunc[T](func(a T, b T) bool {
+		return less(b, a)
+	}))
+	for x := range seq {
+		switch {
+		case h.Len() < k:
+			h.Push(x)
+		case less(x, h.top()):
+			h.data[0] = x
+			h.down(0, h.Len())
+		}
+	}
+
+	values := make([]T, h.Len())
+	for i := len(values) - 1; i >= 0; i-- {
+		values[i] = h.Pop()
+	}
+	return values
+}
+
+// Merge returns an iterator that merges seqs, each of which must already be
+// sorted by less, into a single sorted sequence. Equal values are yielded in
+// the order of the seqs that yielded them.
+func Merge[T any](less func(a T, b T) bool, seqs ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		h, stop := newMergeHeap(less, seqs)
+		defer stop()
+
+		for h.Len() > 0 {
+			head := &h.data[0]
+			if !yield(head.value) {
+				return
+			}
+
+			if x, ok := head.next(); ok {
+				head.value = x
+				h.down(0, h.Len())
+			} else {
+				h.Pop()
+			}
+		}
+	}
+}
+
+// A mergeHead holds the next value of one of the sequences passed to
+// [Merge].
+type mergeHead[T any] struct {
+	next  func() (T, bool)
+	value T
+	index int
+}
+
+// newMergeHeap pulls the first value from each of seqs, returning a heap of
+// the non-empty sequences and a function that stops all of them.
+func newMergeHeap[T any](
+	less func(a T, b T) bool,
+	seqs []iter.Seq[T],
+) (heap[mergeHead[T], heapTypeFunc[mergeHead[T]]], func()) {
+	var (
+		heads = make([]mergeHead[T], 0, len(seqs))
+		stops = make([]func(), 0, len(seqs))
+	)
+	for i, seq := range seqs {
+		next, stop := iter.Pull(seq)
+		stops = append(stops, stop)
+		if x, ok := next(); ok {
+			heads = append(heads, mergeHead[T]{
+				next:  next,
+				value: x,
+				index: i,
+			})
+		}
+	}
+
+	h := newHeap(heapTypeFunc[mergeHead[T]](func(a, b mergeHead[T]) bool {
+		if less(a.value, b.value) {
+			return true
+		}
+		return !less(b.value, a.value) && a.index < b.index
+	}), heads...)
+
+	return h, func() {
+		for _, stop := range stops {
+			stop()
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package heap_test
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/container/heap"
+)
+
+func TestTopK(t *testing.T) {
+	var (
+		rng    = rand.New(rand.NewPCG(1, 2))
+		values = rng.Perm(1000)
+		seq    = slices.Values(values)
+	)
+
+	require.Equal(t, []int{0, 1, 2, 3, 4}, heap.TopK(seq, 5, cmp.Less[int]))
+	require.Equal(
+		t,
+		[]int{999, 998, 997},
+		heap.TopK(seq, 3, func(a int, b int) bool { return a > b }),
+	)
+	require.Nil(t, heap.TopK(seq, 0, cmp.Less[int]))
+
+	sorted := slices.Sorted(seq)
+	require.Equal(t, sorted, heap.TopK(seq, 1000, cmp.Less[int]))
+	require.Equal(t, sorted, heap.TopK(seq, 2000, cmp.Less[int]))
+}
+
+func TestMerge(t *testing.T) {
+	type pair struct {
+		key int
+		seq int
+	}
+
+	byKey := func(a pair, b pair) bool {
+		return a.key < b.key
+	}
+
+	var (
+		a = []pair{{1, 0}, {3, 0}, {5, 0}, {5, 0}}
+		b = []pair{{2, 1}, {3, 1}, {6, 1}}
+		c = []pair{{0, 3}, {3, 3}}
+	)
+
+	have := slices.Collect(heap.Merge(
+		byKey,
+		slices.Values(a),
+		slices.Values(b),
+		slices.Values([]pair(nil)),
+		slices.Values(c),
+	))
+	require.Equal(t, []pair{
+		{0, 3},
+		{1, 0},
+		{2, 1},
+		{3, 0},
+		{3, 1},
+		{3, 3},
+		{5, 0},
+		{5, 0},
+		{6, 1},
+	}, have)
+
+	require.Empty(t, slices.Collect(heap.Merge(byKey)))
+}
+
+func TestMerge_Stop(t *testing.T) {
+	var stopped int
+	seq := func(start int) func(func(int) bool) {
+		return func(yield func(int) bool) {
+			defer func() { stopped++ }()
+			for i := start; ; i += 2 {
+				if !yield(i) {
+					return
+				}
+			}
+		}
+	}
+
+	var have []int
+	for x := range heap.Merge(cmp.Less[int], seq(0), seq(1)) {
+		if x == 5 {
+			break
+		}
+		have = append(have, x)
+	}
+
+	require.Equal(t, []int{0, 1, 2, 3, 4}, have)
+	require.Equal(t, 2, stopped)
+}
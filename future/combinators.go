@@ -0,0 +1,244 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package future
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrNoPromises is returned by combinators that require at least one
+// [Promise] when none are given.
+var ErrNoPromises = errors.New("no promises given")
+
+// Then returns a new [Future] that, once p holds a value, is resolved with the
+// result of calling fn with that value. If p fails, the returned future fails
+// with the same error; if p is canceled, the returned future is canceled. If
+// ctx expires before the returned future completes, the returned future fails
+// with [ErrContextExpired]; if fn panics, the returned future fails with a
+// [*PanicError]. Canceling the returned future cancels the context passed to
+// fn.
+func Then[T any, U any](
+	ctx context.Context,
+	p Promise[T],
+	fn func(context.Context, T) (U, error),
+) *Future[U] {
+	out, ctx := derive[U](ctx)
+	go func() {
+		value, err := p.WaitContextErr(ctx)
+		if err != nil {
+			var zero U
+			resolve(out, zero, err)
+			return
+		}
+
+		result, err := callRecover(ctx, func(ctx context.Context) (U, error) {
+			return fn(ctx, value)
+		})
+		resolve(out, result, err)
+	}()
+	return out
+}
+
+// Map returns a new [Future] that, once p holds a value, is set to the result
+// of calling fn with that value. It otherwise behaves like [Then].
+func Map[T any, U any](
+	ctx context.Context,
+	p Promise[T],
+	fn func(T) U,
+) *Future[U] {
+	return Then(ctx, p, func(_ context.Context, value T) (U, error) {
+		return fn(value), nil
+	})
+}
+
+// All returns a new [Future] that is set to the values of all of the given
+// promises, in order, once each of them holds a value. If any promise fails
+// or is canceled, the returned future immediately fails or is canceled
+// accordingly, without waiting for the remaining promises. If ctx expires
+// before the returned future completes, the returned future fails with
+// [ErrContextExpired].
+func All[T any](
+	ctx context.Context,
+	promises []Promise[T],
+	opts ...Option,
+) *Future[[]T] {
+	out, ctx := derive[[]T](ctx)
+	cancelRemaining(out, promises, opts)
+
+	if len(promises) == 0 {
+		out.Set([]T{})
+		return out
+	}
+
+	var (
+		values    = make([]T, len(promises))
+		mu        sync.Mutex
+		remaining = len(promises)
+	)
+
+	for i := range promises {
+		go func() {
+			value, err := promises[i].WaitContextErr(ctx)
+			if err != nil {
+				resolve(out, nil, err)
+				return
+			}
+
+			mu.Lock()
+			values[i] = value
+			remaining--
+			done := remaining == 0
+			mu.Unlock()
+
+			if done {
+				out.Set(values)
+			}
+		}()
+	}
+
+	return out
+}
+
+// Any returns a new [Future] that is set to the value of the first of the
+// given promises to hold a value. If every promise fails or is canceled, the
+// returned future fails with an error that joins each promise's error. If ctx
+// expires before the returned future completes, the returned future fails
+// with [ErrContextExpired]. If no promises are given, the returned future
+// fails with [ErrNoPromises].
+func Any[T any](
+	ctx context.Context,
+	promises []Promise[T],
+	opts ...Option,
+) *Future[T] {
+	out, ctx := derive[T](ctx)
+	cancelRemaining(out, promises, opts)
+
+	if len(promises) == 0 {
+		out.SetErr(ErrNoPromises)
+		return out
+	}
+
+	var (
+		errs      = make([]error, len(promises))
+		mu        sync.Mutex
+		remaining = len(promises)
+	)
+
+	for i := range promises {
+		go func() {
+			value, err := promises[i].WaitContextErr(ctx)
+			switch {
+			case err == nil:
+				out.Set(value)
+				return
+			case ctx.Err() != nil:
+				// n.b. Only fail early if Any's own context expired; an input
+				//      that failed because of its own context is treated like
+				//      any other failure.
+				out.SetErr(err)
+				return
+			}
+
+			mu.Lock()
+			errs[i] = err
+			remaining--
+			done := remaining == 0
+			mu.Unlock()
+
+			if done {
+				out.SetErr(errors.Join(errs...))
+			}
+		}()
+	}
+
+	return out
+}
+
+// Race returns a new [Future] that completes in the same way as the first of
+// the given promises to complete: it is set to that promise's value, fails
+// with that promise's error, or is canceled. If ctx expires before the
+// returned future completes, the returned future fails with
+// [ErrContextExpired]. If no promises are given, the returned future fails
+// with [ErrNoPromises].
+func Race[T any](
+	ctx context.Context,
+	promises []Promise[T],
+	opts ...Option,
+) *Future[T] {
+	out, ctx := derive[T](ctx)
+	cancelRemaining(out, promises, opts)
+
+	if len(promises) == 0 {
+		out.SetErr(ErrNoPromises)
+		return out
+	}
+
+	for i := range promises {
+		go func() {
+			value, err := promises[i].WaitContextErr(ctx)
+			resolve(out, value, err)
+		}()
+	}
+
+	return out
+}
+
+// derive creates a new [Future] along with a child of ctx that is canceled
+// once the future completes.
+func derive[T any](ctx context.Context) (*Future[T], context.Context) {
+	out := New[T]()
+	ctx, cancel := context.WithCancel(ctx)
+	out.onDone(cancel)
+	return out, ctx
+}
+
+// cancelRemaining cancels each of the given promises once out completes, if
+// configured to do so by opts.
+func cancelRemaining[T any, U any](
+	out *Future[U],
+	promises []Promise[T],
+	opts []Option,
+) {
+	if !(options{}).With(opts...).CancelRemaining {
+		return
+	}
+
+	out.onDone(func() {
+		for _, p := range promises {
+			p.future.Cancel()
+		}
+	})
+}
+
+// resolve completes f using the given value and error. If err is (or wraps)
+// [ErrCanceled], f is canceled.
+func resolve[T any](f *Future[T], value T, err error) {
+	switch {
+	case err == nil:
+		f.Set(value)
+	case errors.Is(err, ErrCanceled):
+		f.Cancel()
+	default:
+		f.SetErr(err)
+	}
+}
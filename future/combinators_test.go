@@ -0,0 +1,435 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package future_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/future"
+)
+
+func TestThen(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		src := future.New[int]()
+		out := future.Then(
+			context.Background(),
+			src.Promise(),
+			func(_ context.Context, x int) (string, error) {
+				return strconv.Itoa(x * 2), nil
+			},
+		)
+
+		src.Set(123)
+		have, err := waitErr(t, out)
+		require.NoError(t, err)
+		require.Equal(t, "246", have)
+	})
+
+	t.Run("fn error", func(t *testing.T) {
+		var (
+			src     = future.New[int]()
+			wantErr = errors.New(t.Name())
+			out     = future.Then(
+				context.Background(),
+				src.Promise(),
+				func(context.Context, int) (string, error) {
+					return "", wantErr
+				},
+			)
+		)
+
+		src.Set(123)
+		_, err := waitErr(t, out)
+		require.ErrorIs(t, err, wantErr)
+	})
+
+	t.Run("fn panic", func(t *testing.T) {
+		var (
+			src = future.New[int]()
+			out = future.Map(
+				context.Background(),
+				src.Promise(),
+				func(int) string { panic("oh no") },
+			)
+		)
+
+		src.Set(123)
+		_, err := waitErr(t, out)
+
+		var perr *future.PanicError
+		require.ErrorAs(t, err, &perr)
+		require.Equal(t, "oh no", perr.Value)
+	})
+
+	t.Run("source error", func(t *testing.T) {
+		var (
+			src     = future.New[int]()
+			wantErr = errors.New(t.Name())
+			out     = future.Then(
+				context.Background(),
+				src.Promise(),
+				func(context.Context, int) (string, error) {
+					require.FailNow(t, "unexpected call")
+					return "", nil
+				},
+			)
+		)
+
+		src.SetErr(wantErr)
+		_, err := waitErr(t, out)
+		require.ErrorIs(t, err, wantErr)
+		require.False(t, out.IsCanceled())
+	})
+
+	t.Run("source canceled", func(t *testing.T) {
+		var (
+			src = future.New[int]()
+			out = future.Map(context.Background(), src.Promise(), strconv.Itoa)
+		)
+
+		src.Cancel()
+		_, err := waitErr(t, out)
+		require.ErrorIs(t, err, future.ErrCanceled)
+		require.True(t, out.IsCanceled())
+	})
+
+	t.Run("context expired", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		var (
+			src = future.New[int]()
+			out = future.Map(ctx, src.Promise(), strconv.Itoa)
+		)
+
+		cancel()
+		_, err := waitErr(t, out)
+		require.ErrorIs(t, err, future.ErrContextExpired)
+
+		src.Set(123)
+		_, err = waitErr(t, out)
+		require.ErrorIs(t, err, future.ErrContextExpired)
+	})
+
+	t.Run("dependent canceled", func(t *testing.T) {
+		var (
+			src     = future.New[int]()
+			started = make(chan struct{})
+			stopped = make(chan struct{})
+			out     = future.Then(
+				context.Background(),
+				src.Promise(),
+				func(ctx context.Context, _ int) (int, error) {
+					close(started)
+					<-ctx.Done()
+					close(stopped)
+					return 0, ctx.Err()
+				},
+			)
+		)
+
+		src.Set(123)
+		<-started
+		out.Cancel()
+
+		select {
+		case <-stopped:
+		case <-time.After(time.Second):
+			require.FailNow(t, "fn context was not canceled")
+		}
+
+		_, err := waitErr(t, out)
+		require.ErrorIs(t, err, future.ErrCanceled)
+	})
+}
+
+func TestMap(t *testing.T) {
+	var (
+		src = future.New[int]()
+		out = future.Map(context.Background(), src.Promise(), strconv.Itoa)
+	)
+
+	src.Set(123)
+	have, err := waitErr(t, out)
+	require.NoError(t, err)
+	require.Equal(t, "123", have)
+}
+
+func TestAll(t *testing.T) {
+	t.Run("values", func(t *testing.T) {
+		futures, promises := newFutures[int](3)
+		out := future.All(context.Background(), promises)
+
+		for i := len(futures) - 1; i >= 0; i-- {
+			require.False(t, out.IsSet())
+			futures[i].Set(i)
+		}
+
+		have, err := waitErr(t, out)
+		require.NoError(t, err)
+		require.Equal(t, []int{0, 1, 2}, have)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		have, err := waitErr(t, future.All[int](context.Background(), nil))
+		require.NoError(t, err)
+		require.Empty(t, have)
+	})
+
+	t.Run("fail fast", func(t *testing.T) {
+		var (
+			futures, promises = newFutures[int](3)
+			wantErr           = errors.New(t.Name())
+			out               = future.All(context.Background(), promises)
+		)
+
+		futures[1].SetErr(wantErr)
+		_, err := waitErr(t, out)
+		require.ErrorIs(t, err, wantErr)
+		require.False(t, futures[0].IsCanceled())
+		require.False(t, futures[2].IsCanceled())
+	})
+
+	t.Run("cancel fast", func(t *testing.T) {
+		var (
+			futures, promises = newFutures[int](3)
+			out               = future.All(
+				context.Background(),
+				promises,
+				future.CancelRemaining(true),
+			)
+		)
+
+		futures[0].Set(123)
+		futures[2].Cancel()
+		_, err := waitErr(t, out)
+		require.ErrorIs(t, err, future.ErrCanceled)
+		require.True(t, out.IsCanceled())
+		require.True(t, futures[0].IsSet())
+		require.True(t, futures[1].IsCanceled())
+	})
+
+	t.Run("context expired", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		var (
+			futures, promises = newFutures[int](3)
+			out               = future.All(ctx, promises)
+		)
+
+		futures[0].Set(123)
+		cancel()
+		_, err := waitErr(t, out)
+		require.ErrorIs(t, err, future.ErrContextExpired)
+	})
+}
+
+func TestAny(t *testing.T) {
+	t.Run("first value", func(t *testing.T) {
+		var (
+			futures, promises = newFutures[int](3)
+			out               = future.Any(
+				context.Background(),
+				promises,
+				future.CancelRemaining(true),
+			)
+		)
+
+		futures[0].SetErr(errors.New(t.Name()))
+		futures[2].Set(123)
+
+		have, err := waitErr(t, out)
+		require.NoError(t, err)
+		require.Equal(t, 123, have)
+		require.True(t, futures[1].IsCanceled())
+	})
+
+	t.Run("all failed", func(t *testing.T) {
+		var (
+			futures, promises = newFutures[int](3)
+			errA              = errors.New("a")
+			errB              = errors.New("b")
+			out               = future.Any(context.Background(), promises)
+		)
+
+		futures[0].SetErr(errA)
+		futures[1].Cancel()
+		require.False(t, out.IsSet())
+		futures[2].SetErr(errB)
+
+		_, err := waitErr(t, out)
+		require.ErrorIs(t, err, errA)
+		require.ErrorIs(t, err, errB)
+		require.ErrorIs(t, err, future.ErrCanceled)
+		require.False(t, out.IsCanceled())
+	})
+
+	t.Run("input context expired", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(
+			context.Background(),
+			time.Millisecond,
+		)
+		defer cancel()
+
+		var (
+			release = make(chan struct{})
+			expired = future.Go(ctx, func(context.Context) (int, error) {
+				<-release
+				return 0, nil
+			})
+			ok = future.Go(
+				context.Background(),
+				func(context.Context) (int, error) {
+					<-release
+					return 42, nil
+				},
+			)
+			out = future.Any(
+				context.Background(),
+				[]future.Promise[int]{expired, ok},
+			)
+		)
+
+		// The first input fails with its own context's error, which must not
+		// fail Any while the second input may still succeed.
+		_, err := waitPromiseErr(t, expired)
+		require.ErrorIs(t, err, future.ErrContextExpired)
+		close(release)
+
+		have, err := waitErr(t, out)
+		require.NoError(t, err)
+		require.Equal(t, 42, have)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := waitErr(t, future.Any[int](context.Background(), nil))
+		require.ErrorIs(t, err, future.ErrNoPromises)
+	})
+
+	t.Run("context expired", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		_, promises := newFutures[int](3)
+		out := future.Any(ctx, promises)
+
+		cancel()
+		_, err := waitErr(t, out)
+		require.ErrorIs(t, err, future.ErrContextExpired)
+	})
+}
+
+func TestRace(t *testing.T) {
+	t.Run("first value", func(t *testing.T) {
+		var (
+			futures, promises = newFutures[int](3)
+			out               = future.Race(context.Background(), promises)
+		)
+
+		futures[1].Set(123)
+		have, err := waitErr(t, out)
+		require.NoError(t, err)
+		require.Equal(t, 123, have)
+
+		futures[0].SetErr(errors.New(t.Name()))
+		have, err = waitErr(t, out)
+		require.NoError(t, err)
+		require.Equal(t, 123, have)
+		require.False(t, futures[2].IsCanceled())
+	})
+
+	t.Run("first error", func(t *testing.T) {
+		var (
+			futures, promises = newFutures[int](3)
+			wantErr           = errors.New(t.Name())
+			out               = future.Race(
+				context.Background(),
+				promises,
+				future.CancelRemaining(true),
+			)
+		)
+
+		futures[2].SetErr(wantErr)
+
+		_, err := waitErr(t, out)
+		require.ErrorIs(t, err, wantErr)
+		require.True(t, futures[0].IsCanceled())
+		require.True(t, futures[1].IsCanceled())
+	})
+
+	t.Run("first canceled", func(t *testing.T) {
+		var (
+			futures, promises = newFutures[int](3)
+			out               = future.Race(context.Background(), promises)
+		)
+
+		futures[0].Cancel()
+
+		_, err := waitErr(t, out)
+		require.ErrorIs(t, err, future.ErrCanceled)
+		require.True(t, out.IsCanceled())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := waitErr(t, future.Race[int](context.Background(), nil))
+		require.ErrorIs(t, err, future.ErrNoPromises)
+	})
+
+	t.Run("dependent canceled", func(t *testing.T) {
+		var (
+			futures, promises = newFutures[int](3)
+			out               = future.Race(
+				context.Background(),
+				promises,
+				future.CancelRemaining(true),
+			)
+		)
+
+		out.Cancel()
+		for _, f := range futures {
+			require.True(t, f.IsCanceled())
+		}
+	})
+}
+
+func newFutures[T any](n int) ([]*future.Future[T], []future.Promise[T]) {
+	var (
+		futures  = make([]*future.Future[T], n)
+		promises = make([]future.Promise[T], n)
+	)
+	for i := range n {
+		futures[i] = future.New[T]()
+		promises[i] = futures[i].Promise()
+	}
+	return futures, promises
+}
+
+func waitErr[T any](t *testing.T, f *future.Future[T]) (T, error) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	value, err := f.WaitContextErr(ctx)
+	require.True(t, f.IsSet() || f.Err() != nil, "timed out")
+	return value, err
+}
@@ -23,71 +23,96 @@ package future
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
 )
 
+var (
+	// ErrCanceled is returned when waiting on a [Future] that was canceled.
+	ErrCanceled = errors.New("future canceled")
+	// ErrContextExpired is returned when a context used to wait on a [Future]
+	// expires before the future completes.
+	ErrContextExpired = errors.New("context expired before future completed")
+)
+
 // A Future is a type that may hold a value now or in the future.
 type Future[T any] struct {
-	value    T
-	rmu      sync.RWMutex
-	wmu      sync.Mutex
-	wg       sync.WaitGroup
-	isset    bool
-	canceled bool
+	value     T
+	err       error
+	done      chan struct{}
+	callbacks []func()
+	mu        sync.Mutex
+	isset     bool
+	canceled  bool
 }
 
 // New creates a new [Future].
 func New[T any]() *Future[T] {
-	v := &Future[T]{}
-	v.rmu.Lock()
-	return v
+	return &Future[T]{
+		done: make(chan struct{}),
+	}
 }
 
 // Cancel cancels the future, if it has not already been canceled or had a
-// value set.
+// value or error set.
 func (f *Future[T]) Cancel() {
-	f.wmu.Lock()
-	defer f.wmu.Unlock()
-	if f.isset || f.canceled {
-		return
-	}
-	f.canceled = true
-	f.rmu.Unlock()
+	f.complete(func() {
+		f.canceled = true
+		f.err = ErrCanceled
+	})
 }
 
 // IsCanceled returns whether the future has been canceled.
 func (f *Future[T]) IsCanceled() bool {
-	f.wmu.Lock()
-	defer f.wmu.Unlock()
+	f.mu.Lock()
+	defer f.mu.Unlock()
 	return f.canceled
 }
 
 // Set sets the future's value to the given value, if it has not been canceled
-// or previously had a value set.
+// or previously had a value or error set.
 func (f *Future[T]) Set(value T) {
-	f.wmu.Lock()
-	defer f.wmu.Unlock()
-	if f.isset || f.canceled {
+	f.complete(func() {
+		f.isset = true
+		f.value = value
+	})
+}
+
+// SetErr fails the future with the given error, if it has not been canceled
+// or previously had a value or error set. If err is nil, SetErr does nothing.
+func (f *Future[T]) SetErr(err error) {
+	if err == nil {
 		return
 	}
-	f.isset = true
-	f.value = value
-	f.rmu.Unlock()
+
+	f.complete(func() {
+		f.err = err
+	})
 }
 
 // IsSet returns whether the future has a value set.
 func (f *Future[T]) IsSet() bool {
-	f.wmu.Lock()
-	defer f.wmu.Unlock()
+	f.mu.Lock()
+	defer f.mu.Unlock()
 	return f.isset
 }
 
+// Err returns the error that the future failed with, if any. If the future was
+// canceled, the returned error is [ErrCanceled]. If the future holds a value
+// or has not yet completed, the returned error is nil.
+func (f *Future[T]) Err() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.err
+}
+
 // Get returns the future's value, if it currently holds one. The boolean
 // indicates whether the returned value is valid (i.e., the returned value was
 // explicitly set).
 func (f *Future[T]) Get() (T, bool) {
-	f.wmu.Lock()
-	defer f.wmu.Unlock()
+	f.mu.Lock()
+	defer f.mu.Unlock()
 	if !f.isset {
 		var zero T
 		return zero, false
@@ -95,49 +120,62 @@ func (f *Future[T]) Get() (T, bool) {
 	return f.value, true
 }
 
-// Wait waits for the future to hold a value or to be canceled, and returns its
-// value afterward. The boolean indicates whether the returned value is valid
-// (i.e., the returned value was explicitly set).
+// Done returns a channel that is closed once the future holds a value, fails,
+// or is canceled.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Wait waits for the future to hold a value, to fail, or to be canceled, and
+// returns its value afterward. The boolean indicates whether the returned
+// value is valid (i.e., the returned value was explicitly set).
 func (f *Future[T]) Wait() (T, bool) {
-	f.rmu.RLock()
-	defer f.rmu.RUnlock()
+	<-f.done
 	return f.value, f.isset
 }
 
-// WaitContext waits for the future to hold a value, to be canceled, or for ctx
-// to be canceled, and returns its value afterward. The boolean indicates
-// whether the returned value is valid (i.e., the returned value was explicitly
-// set).
+// WaitErr waits for the future to hold a value, to fail, or to be canceled,
+// and returns its value or error afterward. If the future was canceled, the
+// returned error is [ErrCanceled].
+func (f *Future[T]) WaitErr() (T, error) {
+	<-f.done
+	return f.value, f.err
+}
+
+// WaitContext waits for the future to hold a value, to fail, to be canceled,
+// or for ctx to be canceled, and returns its value afterward. The boolean
+// indicates whether the returned value is valid (i.e., the returned value was
+// explicitly set).
 func (f *Future[T]) WaitContext(ctx context.Context) (T, bool) {
-	if x, ok := f.Get(); ok {
-		return x, true
-	}
+	value, err := f.WaitContextErr(ctx)
+	return value, err == nil
+}
 
-	var (
-		update = make(chan T, 1)
-		value  T
-		valid  bool
-	)
-
-	f.wg.Add(1)
-	go func() {
-		defer f.wg.Done()
-		defer close(update)
-		if val, ok := f.Wait(); ok {
-			update <- val
-		}
-	}()
+// WaitContextErr waits for the future to hold a value, to fail, to be
+// canceled, or for ctx to be canceled, and returns its value or error
+// afterward. If the future was canceled, the returned error is [ErrCanceled];
+// if ctx expired first, the returned error wraps both [ErrContextExpired] and
+// the context's error.
+func (f *Future[T]) WaitContextErr(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.value, f.err
+	default:
+	}
 
 	select {
 	case <-ctx.Done():
 		// n.b. If the context is done but there's a value, prefer the value.
 		select {
-		case value, valid = <-update:
+		case <-f.done:
+			return f.value, f.err
 		default:
+			var zero T
+			return zero, contextExpired(ctx)
 		}
-	case value, valid = <-update:
+	case <-f.done:
+		return f.value, f.err
 	}
-	return value, valid
 }
 
 // Promise returns a [Promise] that is bound to the future.
@@ -147,6 +185,60 @@ func (f *Future[T]) Promise() Promise[T] {
 	}
 }
 
+// complete calls apply to transition the future into a completed state, if
+// the future has not already completed. Any registered callbacks are run
+// before waiters are released.
+func (f *Future[T]) complete(apply func()) {
+	f.mu.Lock()
+	if f.isDoneUnsafe() {
+		f.mu.Unlock()
+		return
+	}
+
+	apply()
+	callbacks := f.callbacks
+	f.callbacks = nil
+	f.mu.Unlock()
+
+	for _, fn := range callbacks {
+		fn()
+	}
+	close(f.done)
+}
+
+// onDone registers fn to be called once the future completes. If the future
+// has already completed, fn is called immediately.
+func (f *Future[T]) onDone(fn func()) {
+	f.mu.Lock()
+	if f.isDoneUnsafe() {
+		f.mu.Unlock()
+		fn()
+		return
+	}
+
+	f.callbacks = append(f.callbacks, fn)
+	f.mu.Unlock()
+}
+
+// follow returns a new [Future] that completes in the same way as f.
+// Canceling the returned future does not affect f, so it may be handed to
+// callers that share f without owning it.
+func follow[T any](f *Future[T]) *Future[T] {
+	out := New[T]()
+	f.onDone(func() {
+		resolve(out, f.value, f.err)
+	})
+	return out
+}
+
+func (f *Future[T]) isDoneUnsafe() bool {
+	return f.isset || f.err != nil
+}
+
+func contextExpired(ctx context.Context) error {
+	return fmt.Errorf("%w: %w", ErrContextExpired, ctx.Err())
+}
+
 // A Promise is the receiving portion of a [Future].
 type Promise[T any] struct {
 	future *Future[T]
@@ -162,6 +254,19 @@ func (p *Promise[T]) IsCanceled() bool {
 	return p.future.IsCanceled()
 }
 
+// Done returns a channel that is closed once the promise holds a value, fails,
+// or is canceled.
+func (p *Promise[T]) Done() <-chan struct{} {
+	return p.future.Done()
+}
+
+// Err returns the error that the promise failed with, if any. If the promise
+// was canceled, the returned error is [ErrCanceled]. If the promise holds a
+// value or has not yet completed, the returned error is nil.
+func (p *Promise[T]) Err() error {
+	return p.future.Err()
+}
+
 // Get returns the promise's value, if it currently holds one. The boolean
 // indicates whether the returned value is valid (i.e., the returned value was
 // explicitly set).
@@ -169,17 +274,33 @@ func (p *Promise[T]) Get() (T, bool) {
 	return p.future.Get()
 }
 
-// Wait waits for the promise to hold a value or to be canceled, and returns
-// its value afterward. The boolean indicates whether the returned value is
-// valid (i.e., the returned value was explicitly set).
+// Wait waits for the promise to hold a value, to fail, or to be canceled, and
+// returns its value afterward. The boolean indicates whether the returned
+// value is valid (i.e., the returned value was explicitly set).
 func (p *Promise[T]) Wait() (T, bool) {
 	return p.future.Wait()
 }
 
-// WaitContext waits for the promise to hold a value, to be canceled, or for
-// ctx to be canceled, and returns its value afterward. The boolean indicates
-// whether the returned value is valid (i.e., the returned value was explicitly
-// set).
+// WaitErr waits for the promise to hold a value, to fail, or to be canceled,
+// and returns its value or error afterward. If the promise was canceled, the
+// returned error is [ErrCanceled].
+func (p *Promise[T]) WaitErr() (T, error) {
+	return p.future.WaitErr()
+}
+
+// WaitContext waits for the promise to hold a value, to fail, to be canceled,
+// or for ctx to be canceled, and returns its value afterward. The boolean
+// indicates whether the returned value is valid (i.e., the returned value was
+// explicitly set).
 func (p *Promise[T]) WaitContext(ctx context.Context) (T, bool) {
 	return p.future.WaitContext(ctx)
 }
+
+// WaitContextErr waits for the promise to hold a value, to fail, to be
+// canceled, or for ctx to be canceled, and returns its value or error
+// afterward. If the promise was canceled, the returned error is
+// [ErrCanceled]; if ctx expired first, the returned error wraps both
+// [ErrContextExpired] and the context's error.
+func (p *Promise[T]) WaitContextErr(ctx context.Context) (T, error) {
+	return p.future.WaitContextErr(ctx)
+}
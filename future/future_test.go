@@ -22,6 +22,8 @@ package future_test
 
 import (
 	"context"
+	"errors"
+	"runtime"
 	"sync"
 	"testing"
 	"time"
@@ -72,6 +74,171 @@ func TestWaitContext(t *testing.T) {
 	require.Equal(t, 123, have)
 }
 
+func TestSetErr(t *testing.T) {
+	var (
+		f       = future.New[int]()
+		p       = f.Promise()
+		wantErr = errors.New(t.Name())
+	)
+
+	require.NoError(t, f.Err())
+	f.SetErr(nil)
+	require.NoError(t, f.Err())
+
+	f.SetErr(wantErr)
+	f.SetErr(errors.New("nope"))
+	f.Set(123)
+	f.Cancel()
+
+	require.False(t, f.IsSet())
+	require.False(t, f.IsCanceled())
+	require.ErrorIs(t, f.Err(), wantErr)
+	require.ErrorIs(t, p.Err(), wantErr)
+
+	have, ok := f.Get()
+	require.False(t, ok)
+	require.Zero(t, have)
+	have, ok = f.Wait()
+	require.False(t, ok)
+	require.Zero(t, have)
+	have, ok = p.WaitContext(context.Background())
+	require.False(t, ok)
+	require.Zero(t, have)
+
+	have, err := f.WaitErr()
+	require.ErrorIs(t, err, wantErr)
+	require.Zero(t, have)
+	have, err = p.WaitErr()
+	require.ErrorIs(t, err, wantErr)
+	require.Zero(t, have)
+	have, err = f.WaitContextErr(context.Background())
+	require.ErrorIs(t, err, wantErr)
+	require.Zero(t, have)
+	have, err = p.WaitContextErr(context.Background())
+	require.ErrorIs(t, err, wantErr)
+	require.Zero(t, have)
+}
+
+func TestWaitContextErr(t *testing.T) {
+	t.Run("context expired", func(t *testing.T) {
+		var (
+			f = future.New[int]()
+			p = f.Promise()
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		have, err := f.WaitContextErr(ctx)
+		require.ErrorIs(t, err, future.ErrContextExpired)
+		require.ErrorIs(t, err, context.Canceled)
+		require.NotErrorIs(t, err, future.ErrCanceled)
+		require.Zero(t, have)
+		have, err = p.WaitContextErr(ctx)
+		require.ErrorIs(t, err, future.ErrContextExpired)
+		require.Zero(t, have)
+
+		f.Set(123)
+		have, err = p.WaitContextErr(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 123, have)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		var (
+			f = future.New[int]()
+			p = f.Promise()
+		)
+
+		time.AfterFunc(50*time.Millisecond, f.Cancel)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		have, err := p.WaitContextErr(ctx)
+		require.ErrorIs(t, err, future.ErrCanceled)
+		require.NotErrorIs(t, err, future.ErrContextExpired)
+		require.Zero(t, have)
+	})
+
+	t.Run("value", func(t *testing.T) {
+		f := future.New[int]()
+		time.AfterFunc(50*time.Millisecond, func() {
+			f.Set(123)
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		have, err := f.WaitContextErr(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 123, have)
+	})
+}
+
+func TestDone(t *testing.T) {
+	cases := map[string]func(*future.Future[int]){
+		"set":      func(f *future.Future[int]) { f.Set(123) },
+		"set err":  func(f *future.Future[int]) { f.SetErr(errors.New("x")) },
+		"canceled": func(f *future.Future[int]) { f.Cancel() },
+	}
+
+	for name, complete := range cases {
+		t.Run(name, func(t *testing.T) {
+			var (
+				f = future.New[int]()
+				p = f.Promise()
+			)
+
+			select {
+			case <-f.Done():
+				require.FailNow(t, "future done before completion")
+			case <-p.Done():
+				require.FailNow(t, "promise done before completion")
+			default:
+			}
+
+			time.AfterFunc(50*time.Millisecond, func() {
+				complete(f)
+			})
+
+			select {
+			case <-p.Done():
+			case <-time.After(time.Second):
+				require.FailNow(t, "timed out waiting for promise")
+			}
+
+			select {
+			case <-f.Done():
+			default:
+				require.FailNow(t, "future not done after completion")
+			}
+		})
+	}
+}
+
+func TestWaitContext_NoLeak(t *testing.T) {
+	f := future.New[int]()
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		10*time.Millisecond,
+	)
+	defer cancel()
+
+	before := runtime.NumGoroutine()
+	for range 1000 {
+		_, err := f.WaitContextErr(ctx)
+		require.ErrorIs(t, err, future.ErrContextExpired)
+	}
+	require.LessOrEqual(t, runtime.NumGoroutine(), before)
+
+	f.Set(123)
+	have, ok := f.WaitContext(ctx)
+	require.True(t, ok)
+	require.Equal(t, 123, have)
+}
+
 func TestWaitRoutine(t *testing.T) {
 	const want = 123
 	var (
@@ -145,6 +312,8 @@ func testFuture(t *testing.T, fn func() *future.Future[int]) {
 
 		f.Set(123)
 		f.Set(456)
+		f.SetErr(errors.New("nope"))
+		require.NoError(t, f.Err())
 		for range 3 {
 			have, ok = f.Get()
 			require.True(t, ok)
@@ -172,9 +341,13 @@ func testFuture(t *testing.T, fn func() *future.Future[int]) {
 		f.Cancel()
 		require.False(t, f.IsSet())
 		require.True(t, f.IsCanceled())
+		require.ErrorIs(t, f.Err(), future.ErrCanceled)
+		require.ErrorIs(t, p.Err(), future.ErrCanceled)
 		f.Cancel() // double cancel for sanity
 		f.Set(123)
+		f.SetErr(errors.New("nope"))
 		require.False(t, f.IsSet())
+		require.ErrorIs(t, f.Err(), future.ErrCanceled)
 
 		have, ok := f.Get()
 		require.False(t, ok)
@@ -188,5 +361,12 @@ func testFuture(t *testing.T, fn func() *future.Future[int]) {
 		have, ok = p.Wait()
 		require.False(t, ok)
 		require.Zero(t, have)
+
+		have, err := f.WaitErr()
+		require.ErrorIs(t, err, future.ErrCanceled)
+		require.Zero(t, have)
+		have, err = p.WaitErr()
+		require.ErrorIs(t, err, future.ErrCanceled)
+		require.Zero(t, have)
 	})
 }
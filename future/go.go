@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package future
+
+import (
+	"context"
+
+	"go.mway.dev/x/internal/panics"
+)
+
+var (
+	_ Executor = ExecutorFunc(nil)
+	_ Executor = (*BoundedExecutor)(nil)
+	_ error    = (*PanicError)(nil)
+)
+
+// An Executor runs functions on behalf of [Async] and [Go].
+type Executor interface {
+	// Execute arranges for fn to be called, typically on another goroutine.
+	// Execute should not block waiting for fn to return.
+	Execute(fn func())
+}
+
+// An ExecutorFunc is a function that implements [Executor].
+type ExecutorFunc func(fn func())
+
+// Execute calls e with fn.
+func (e ExecutorFunc) Execute(fn func()) {
+	e(fn)
+}
+
+// A BoundedExecutor is an [Executor] that runs at most a fixed number of
+// functions concurrently. Functions that cannot be run immediately wait until
+// a running function returns.
+type BoundedExecutor struct {
+	sem chan struct{}
+}
+
+// NewBoundedExecutor creates a new [BoundedExecutor] that runs at most limit
+// functions concurrently. A limit less than 1 is treated as 1.
+func NewBoundedExecutor(limit int) *BoundedExecutor {
+	return &BoundedExecutor{
+		sem: make(chan struct{}, max(limit, 1)),
+	}
+}
+
+// Execute runs fn on a new goroutine once the executor has capacity to do so.
+func (e *BoundedExecutor) Execute(fn func()) {
+	go func() {
+		e.sem <- struct{}{}
+		defer func() { <-e.sem }()
+		fn()
+	}()
+}
+
+// A PanicError is the error that a [Future] fails with when the function
+// producing its value panics.
+type PanicError = panics.Error
+
+// Go calls fn on a new goroutine (or using the [Executor] configured by
+// [WithExecutor]) and returns a [Promise] for its result. It is equivalent to
+// calling [Async] and discarding the ability to cancel the [Future].
+func Go[T any](
+	ctx context.Context,
+	fn func(context.Context) (T, error),
+	opts ...Option,
+) Promise[T] {
+	return Async(ctx, fn, opts...).Promise()
+}
+
+// Async calls fn on a new goroutine (or using the [Executor] configured by
+// [WithExecutor]) and returns a [Future] that is resolved with its result. The
+// context passed to fn is canceled once the future completes, including when
+// the future is canceled. If ctx expires before fn returns, the future fails
+// with [ErrContextExpired]; if fn panics, the future fails with a
+// [*PanicError].
+func Async[T any](
+	ctx context.Context,
+	fn func(context.Context) (T, error),
+	opts ...Option,
+) *Future[T] {
+	var (
+		executor     = (options{}).With(opts...).Executor
+		out, workCtx = derive[T](ctx)
+		stop         = context.AfterFunc(ctx, func() {
+			out.SetErr(contextExpired(ctx))
+		})
+	)
+	out.onDone(func() { stop() })
+
+	if executor == nil {
+		executor = ExecutorFunc(func(fn func()) { go fn() })
+	}
+
+	executor.Execute(func() {
+		if workCtx.Err() != nil {
+			return
+		}
+		value, err := callRecover(workCtx, fn)
+		resolve(out, value, err)
+	})
+
+	return out
+}
+
+func callRecover[T any](
+	ctx context.Context,
+	fn func(context.Context) (T, error),
+) (value T, err error) {
+	defer panics.Recover(&err)
+	return fn(ctx)
+}
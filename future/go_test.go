@@ -0,0 +1,276 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package future_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/future"
+)
+
+func TestGo(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		p := future.Go(
+			context.Background(),
+			func(context.Context) (int, error) {
+				return 123, nil
+			},
+		)
+
+		have, err := waitPromiseErr(t, p)
+		require.NoError(t, err)
+		require.Equal(t, 123, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		wantErr := errors.New(t.Name())
+		p := future.Go(
+			context.Background(),
+			func(context.Context) (int, error) {
+				return 0, wantErr
+			},
+		)
+
+		_, err := waitPromiseErr(t, p)
+		require.ErrorIs(t, err, wantErr)
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		p := future.Go(
+			context.Background(),
+			func(context.Context) (int, error) {
+				panic("oh no")
+			},
+		)
+
+		_, err := waitPromiseErr(t, p)
+
+		var perr *future.PanicError
+		require.ErrorAs(t, err, &perr)
+		require.Equal(t, "oh no", perr.Value)
+		require.Contains(t, string(perr.Stack), "TestGo")
+		require.Equal(t, "panic: oh no", perr.Error())
+		require.NoError(t, perr.Unwrap())
+	})
+
+	t.Run("panic error", func(t *testing.T) {
+		wantErr := errors.New(t.Name())
+		p := future.Go(
+			context.Background(),
+			func(context.Context) (int, error) {
+				panic(wantErr)
+			},
+		)
+
+		_, err := waitPromiseErr(t, p)
+		require.ErrorIs(t, err, wantErr)
+	})
+
+	t.Run("context expired", func(t *testing.T) {
+		var (
+			ctx, cancel = context.WithCancel(context.Background())
+			started     = make(chan struct{})
+			release     = make(chan struct{})
+			p           = future.Go(
+				ctx,
+				func(context.Context) (int, error) {
+					close(started)
+					<-release
+					return 123, nil
+				},
+			)
+		)
+		defer close(release)
+
+		<-started
+		cancel()
+
+		_, err := waitPromiseErr(t, p)
+		require.ErrorIs(t, err, future.ErrContextExpired)
+	})
+
+	t.Run("context already expired", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var called atomic.Bool
+		p := future.Go(
+			ctx,
+			func(context.Context) (int, error) {
+				called.Store(true)
+				return 123, nil
+			},
+		)
+
+		_, err := waitPromiseErr(t, p)
+		require.ErrorIs(t, err, future.ErrContextExpired)
+		require.False(t, called.Load())
+	})
+}
+
+func TestAsync_Cancel(t *testing.T) {
+	var (
+		started = make(chan struct{})
+		stopped = make(chan struct{})
+		f       = future.Async(
+			context.Background(),
+			func(ctx context.Context) (int, error) {
+				close(started)
+				<-ctx.Done()
+				close(stopped)
+				return 0, ctx.Err()
+			},
+		)
+	)
+
+	<-started
+	f.Cancel()
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		require.FailNow(t, "work context was not canceled")
+	}
+
+	_, err := waitErr(t, f)
+	require.ErrorIs(t, err, future.ErrCanceled)
+}
+
+func TestAsync_RaceCancelRemaining(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		started  = make(chan struct{})
+		canceled = make(chan struct{})
+		slow     = future.Go(ctx, func(ctx context.Context) (int, error) {
+			close(started)
+			<-ctx.Done()
+			close(canceled)
+			return 0, ctx.Err()
+		})
+		// n.b. Work that has not started when it is canceled never runs, so
+		//      only finish once the losing work is running.
+		fast = future.Go(ctx, func(context.Context) (int, error) {
+			<-started
+			return 123, nil
+		})
+		out = future.Race(
+			ctx,
+			[]future.Promise[int]{slow, fast},
+			future.CancelRemaining(true),
+		)
+	)
+
+	have, err := waitErr(t, out)
+	require.NoError(t, err)
+	require.Equal(t, 123, have)
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		require.FailNow(t, "losing work was not canceled")
+	}
+	require.True(t, slow.IsCanceled())
+}
+
+func TestBoundedExecutor(t *testing.T) {
+	const limit = 2
+
+	var (
+		executor = future.NewBoundedExecutor(limit)
+		running  atomic.Int32
+		peak     atomic.Int32
+		release  = make(chan struct{})
+		promises = make([]future.Promise[int], 10)
+	)
+
+	for i := range promises {
+		promises[i] = future.Go(
+			context.Background(),
+			func(context.Context) (int, error) {
+				n := running.Add(1)
+				defer running.Add(-1)
+				for {
+					cur := peak.Load()
+					if n <= cur || peak.CompareAndSwap(cur, n) {
+						break
+					}
+				}
+				<-release
+				return i, nil
+			},
+			future.WithExecutor(executor),
+		)
+	}
+
+	require.Eventually(t, func() bool {
+		return running.Load() == limit
+	}, time.Second, time.Millisecond)
+	close(release)
+
+	have, err := waitErr(
+		t,
+		future.All(context.Background(), promises),
+	)
+	require.NoError(t, err)
+	require.Len(t, have, len(promises))
+	for i := range have {
+		require.Equal(t, i, have[i])
+	}
+	require.EqualValues(t, limit, peak.Load())
+}
+
+func TestExecutorFunc(t *testing.T) {
+	var calls int
+	executor := future.ExecutorFunc(func(fn func()) {
+		calls++
+		fn()
+	})
+
+	p := future.Go(
+		context.Background(),
+		func(context.Context) (int, error) {
+			return 123, nil
+		},
+		future.WithExecutor(executor),
+	)
+
+	have, ok := p.Get()
+	require.True(t, ok)
+	require.Equal(t, 123, have)
+	require.Equal(t, 1, calls)
+}
+
+func waitPromiseErr[T any](t *testing.T, p future.Promise[T]) (T, error) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	value, err := p.WaitContextErr(ctx)
+	require.True(t, p.IsSet() || p.Err() != nil, "timed out")
+	return value, err
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package future
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+var _afterFunc = func(d time.Duration, fn func()) func() bool {
+	return time.AfterFunc(d, fn).Stop
+}
+
+// A Group deduplicates concurrent computations of values of type T that are
+// identified by keys of type K. Callers that request a key whose value is
+// already being computed share the in-flight computation instead of starting
+// a new one. If configured with [WithTTL], successful results are also
+// retained for reuse until they expire.
+//
+// A zero Group is ready to use and does not retain results.
+type Group[K comparable, T any] struct {
+	entries map[K]*groupEntry[T]
+	opts    []Option
+	ttl     time.Duration
+	mu      sync.Mutex
+}
+
+type groupEntry[T any] struct {
+	future *Future[T]
+	stop   func() bool
+}
+
+// NewGroup creates a new [Group] configured by the given options.
+func NewGroup[K comparable, T any](opts ...Option) *Group[K, T] {
+	return &Group[K, T]{
+		entries: make(map[K]*groupEntry[T]),
+		opts:    opts,
+		ttl:     (options{}).With(opts...).TTL,
+	}
+}
+
+// Do returns a [Promise] for the value identified by key. If the value is
+// already being computed or is retained, the existing result is shared;
+// otherwise, fn is called to compute it as with [Async]. The context passed
+// to fn carries the values of ctx but is never canceled, since the result may
+// be shared by other callers; callers should use the returned promise's
+// WaitContext methods to bound how long they wait. Each call returns a
+// distinct promise, such that canceling it (e.g. with [CancelRemaining]) does
+// not cancel the shared computation. Failed results are never retained.
+func (g *Group[K, T]) Do(
+	ctx context.Context,
+	key K,
+	fn func(context.Context) (T, error),
+) Promise[T] {
+	g.mu.Lock()
+	if e, ok := g.entries[key]; ok {
+		g.mu.Unlock()
+		return follow(e.future).Promise()
+	}
+
+	if g.entries == nil {
+		g.entries = make(map[K]*groupEntry[T])
+	}
+
+	e := &groupEntry[T]{
+		future: Async(context.WithoutCancel(ctx), fn, g.opts...),
+	}
+	g.entries[key] = e
+	g.mu.Unlock()
+
+	e.future.onDone(func() {
+		g.complete(key, e)
+	})
+	return follow(e.future).Promise()
+}
+
+// Forget forgets any in-flight or retained result for key, so that the next
+// call to [Group.Do] for key starts a new computation. Callers already holding
+// a [Promise] for the forgotten result are unaffected. Forget reports whether
+// there was a result to forget.
+func (g *Group[K, T]) Forget(key K) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	e, ok := g.entries[key]
+	if !ok {
+		return false
+	}
+
+	if e.stop != nil {
+		e.stop()
+	}
+	delete(g.entries, key)
+	return true
+}
+
+// Len returns the number of in-flight or retained results held by g.
+func (g *Group[K, T]) Len() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return len(g.entries)
+}
+
+func (g *Group[K, T]) complete(key K, e *groupEntry[T]) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.entries[key] != e {
+		return // forgotten
+	}
+
+	if g.ttl <= 0 || !e.future.IsSet() {
+		delete(g.entries, key)
+		return
+	}
+
+	e.stop = _afterFunc(g.ttl, func() {
+		g.expire(key, e)
+	})
+}
+
+func (g *Group[K, T]) expire(key K, e *groupEntry[T]) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.entries[key] == e {
+		delete(g.entries, key)
+	}
+}
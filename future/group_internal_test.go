@@ -0,0 +1,114 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package future
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/stub"
+)
+
+func TestGroup_TTL(t *testing.T) {
+	var (
+		expire func()
+		stops  int
+	)
+	afterFunc := func(d time.Duration, fn func()) func() bool {
+		require.Equal(t, time.Minute, d)
+		expire = fn
+		return func() bool {
+			stops++
+			return true
+		}
+	}
+
+	stub.With(&_afterFunc, afterFunc, func() {
+		var (
+			group = NewGroup[string, int](WithTTL(time.Minute))
+			calls int
+			fn    = func(context.Context) (int, error) {
+				calls++
+				return calls, nil
+			}
+			ctx = context.Background()
+		)
+
+		for range 3 {
+			have, err := wait(group.Do(ctx, "key", fn))
+			require.NoError(t, err)
+			require.Equal(t, 1, have)
+		}
+		require.Equal(t, 1, group.Len())
+		require.NotNil(t, expire)
+
+		expire()
+		require.Zero(t, group.Len())
+
+		have, err := wait(group.Do(ctx, "key", fn))
+		require.NoError(t, err)
+		require.Equal(t, 2, have)
+
+		require.True(t, group.Forget("key"))
+		require.Equal(t, 1, stops)
+
+		// Expiring a forgotten entry does not affect its replacement.
+		have, err = wait(group.Do(ctx, "key", fn))
+		require.NoError(t, err)
+		require.Equal(t, 3, have)
+		group.expire("key", &groupEntry[int]{})
+		require.Equal(t, 1, group.Len())
+	})
+}
+
+func TestGroup_TTLErrorsNotRetained(t *testing.T) {
+	stub.With(
+		&_afterFunc,
+		func(time.Duration, func()) func() bool {
+			require.FailNow(t, "unexpected expiry timer")
+			return nil
+		},
+		func() {
+			var (
+				group   = NewGroup[string, int](WithTTL(time.Minute))
+				wantErr = errors.New(t.Name())
+			)
+
+			_, err := wait(group.Do(
+				context.Background(),
+				"key",
+				func(context.Context) (int, error) {
+					return 0, wantErr
+				},
+			))
+			require.ErrorIs(t, err, wantErr)
+			require.Zero(t, group.Len())
+		},
+	)
+}
+
+func wait[T any](p Promise[T]) (T, error) {
+	return p.WaitErr()
+}
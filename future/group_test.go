@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package future_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/future"
+)
+
+func TestGroup(t *testing.T) {
+	var (
+		group   future.Group[string, int]
+		calls   atomic.Int32
+		release = make(chan struct{})
+		fn      = func(context.Context) (int, error) {
+			calls.Add(1)
+			<-release
+			return 123, nil
+		}
+		promises = make([]future.Promise[int], 10)
+	)
+
+	for i := range promises {
+		promises[i] = group.Do(context.Background(), "key", fn)
+	}
+	other := group.Do(context.Background(), "other", fn)
+	require.Equal(t, 2, group.Len())
+
+	close(release)
+
+	have, err := waitErr(
+		t,
+		future.All(context.Background(), promises),
+	)
+	require.NoError(t, err)
+	for _, x := range have {
+		require.Equal(t, 123, x)
+	}
+
+	_, err = waitPromiseErr(t, other)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, calls.Load())
+
+	// Without a TTL, completed results are not retained.
+	require.Zero(t, group.Len())
+	_, err = waitPromiseErr(t, group.Do(context.Background(), "key", fn))
+	require.NoError(t, err)
+	require.EqualValues(t, 3, calls.Load())
+}
+
+func TestGroup_CancelRemaining(t *testing.T) {
+	var (
+		group   future.Group[string, int]
+		release = make(chan struct{})
+		fn      = func(context.Context) (int, error) {
+			<-release
+			return 123, nil
+		}
+		fast   = future.New[int]()
+		shared = group.Do(context.Background(), "key", fn)
+		out    = future.Race(
+			context.Background(),
+			[]future.Promise[int]{
+				fast.Promise(),
+				group.Do(context.Background(), "key", fn),
+			},
+			future.CancelRemaining(true),
+		)
+	)
+
+	// Losing the race cancels one caller's promise, but not the in-flight
+	// computation shared with other callers.
+	fast.Set(1)
+	have, err := waitErr(t, out)
+	require.NoError(t, err)
+	require.Equal(t, 1, have)
+
+	close(release)
+	have, err = waitPromiseErr(t, shared)
+	require.NoError(t, err)
+	require.Equal(t, 123, have)
+}
+
+func TestGroup_Forget(t *testing.T) {
+	var (
+		group   = future.NewGroup[string, int]()
+		calls   atomic.Int32
+		release = make(chan struct{})
+		fn      = func(context.Context) (int, error) {
+			<-release
+			return int(calls.Add(1)), nil
+		}
+	)
+
+	first := group.Do(context.Background(), "key", fn)
+	require.True(t, group.Forget("key"))
+	require.False(t, group.Forget("key"))
+	second := group.Do(context.Background(), "key", fn)
+	close(release)
+
+	a, err := waitPromiseErr(t, first)
+	require.NoError(t, err)
+	b, err := waitPromiseErr(t, second)
+	require.NoError(t, err)
+	require.NotEqual(t, a, b)
+	require.EqualValues(t, 2, calls.Load())
+}
+
+func TestGroup_Panic(t *testing.T) {
+	group := future.NewGroup[string, int]()
+	p := group.Do(
+		context.Background(),
+		"key",
+		func(context.Context) (int, error) {
+			panic("oh no")
+		},
+	)
+
+	_, err := waitPromiseErr(t, p)
+	var perr *future.PanicError
+	require.ErrorAs(t, err, &perr)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package future
+
+import (
+	"context"
+	"sync"
+)
+
+// A Lazy is a value that is computed on first use and shared by all callers
+// afterward.
+type Lazy[T any] struct {
+	fn     func(context.Context) (T, error)
+	future *Future[T]
+	opts   []Option
+	once   sync.Once
+}
+
+// NewLazy creates a new [Lazy] that computes its value by calling fn the
+// first time it is waited on. The computation behaves like [Async], and so
+// panics in fn are captured as a [*PanicError]. The context passed to fn is
+// not canceled when the first caller's context is canceled, since the result
+// is shared by all callers.
+func NewLazy[T any](
+	fn func(context.Context) (T, error),
+	opts ...Option,
+) *Lazy[T] {
+	return &Lazy[T]{
+		fn:   fn,
+		opts: opts,
+	}
+}
+
+// Promise starts computing the lazy value if it has not already been started,
+// and returns a [Promise] for its result. Each call returns a distinct
+// promise, such that canceling it (e.g. with [CancelRemaining]) does not
+// cancel the shared computation.
+func (l *Lazy[T]) Promise() Promise[T] {
+	return follow(l.start(context.Background())).Promise()
+}
+
+// Wait starts computing the lazy value if it has not already been started,
+// and waits for and returns its result.
+func (l *Lazy[T]) Wait() (T, error) {
+	return l.start(context.Background()).WaitErr()
+}
+
+// WaitContext starts computing the lazy value if it has not already been
+// started, and waits for and returns its result. If ctx expires first, the
+// returned error wraps [ErrContextExpired]; the computation itself continues
+// and remains available to subsequent callers.
+func (l *Lazy[T]) WaitContext(ctx context.Context) (T, error) {
+	return l.start(ctx).WaitContextErr(ctx)
+}
+
+func (l *Lazy[T]) start(ctx context.Context) *Future[T] {
+	l.once.Do(func() {
+		l.future = Async(context.WithoutCancel(ctx), l.fn, l.opts...)
+	})
+	return l.future
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package future_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/future"
+)
+
+func TestLazy(t *testing.T) {
+	var (
+		calls   atomic.Int32
+		release = make(chan struct{})
+		lazy    = future.NewLazy(func(context.Context) (int, error) {
+			calls.Add(1)
+			<-release
+			return 123, nil
+		})
+		wg sync.WaitGroup
+	)
+
+	require.Zero(t, calls.Load())
+
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			have, err := lazy.Wait()
+			require.NoError(t, err)
+			require.Equal(t, 123, have)
+		}()
+	}
+
+	p := lazy.Promise()
+	close(release)
+	wg.Wait()
+
+	have, err := waitPromiseErr(t, p)
+	require.NoError(t, err)
+	require.Equal(t, 123, have)
+	require.EqualValues(t, 1, calls.Load())
+}
+
+func TestLazy_WaitContext(t *testing.T) {
+	var (
+		release = make(chan struct{})
+		lazy    = future.NewLazy(func(ctx context.Context) (int, error) {
+			<-release
+			return 0, ctx.Err()
+		})
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := lazy.WaitContext(ctx)
+	require.ErrorIs(t, err, future.ErrContextExpired)
+
+	// The computation is not bound to the first caller's context.
+	close(release)
+	have, err := lazy.Wait()
+	require.NoError(t, err)
+	require.Zero(t, have)
+}
+
+func TestLazy_Error(t *testing.T) {
+	wantErr := errors.New(t.Name())
+	lazy := future.NewLazy(func(context.Context) (int, error) {
+		return 0, wantErr
+	})
+
+	for range 3 {
+		_, err := lazy.Wait()
+		require.ErrorIs(t, err, wantErr)
+	}
+}
+
+func TestLazy_CancelRemaining(t *testing.T) {
+	var (
+		release = make(chan struct{})
+		lazy    = future.NewLazy(func(context.Context) (int, error) {
+			<-release
+			return 123, nil
+		})
+		fast = future.New[int]()
+		out  = future.Race(
+			context.Background(),
+			[]future.Promise[int]{fast.Promise(), lazy.Promise()},
+			future.CancelRemaining(true),
+		)
+	)
+
+	// Losing the race cancels the caller's promise, but not the shared value.
+	fast.Set(1)
+	have, err := waitErr(t, out)
+	require.NoError(t, err)
+	require.Equal(t, 1, have)
+
+	close(release)
+	have, err = lazy.Wait()
+	require.NoError(t, err)
+	require.Equal(t, 123, have)
+
+	have, err = waitPromiseErr(t, lazy.Promise())
+	require.NoError(t, err)
+	require.Equal(t, 123, have)
+}
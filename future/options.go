@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package future
+
+import (
+	"time"
+)
+
+// An Option configures the behavior of functions and types like [Async],
+// [All], [Any], [Race], and [Group]. Options that do not apply to a given
+// function are ignored.
+type Option interface {
+	apply(*options)
+}
+
+// CancelRemaining returns a new [Option] that configures a combinator to
+// cancel any of its inputs that are still pending once the combined [Future]
+// completes (e.g. the losers of a [Race]).
+func CancelRemaining(cancel bool) Option {
+	return optionFunc(func(dst *options) {
+		dst.CancelRemaining = cancel
+	})
+}
+
+// WithExecutor returns a new [Option] that configures [Async] and [Go] to run
+// work using the given [Executor] instead of starting a new goroutine.
+func WithExecutor(executor Executor) Option {
+	return optionFunc(func(dst *options) {
+		dst.Executor = executor
+	})
+}
+
+// WithTTL returns a new [Option] that configures a [Group] to retain
+// successful results for the given duration after they complete, so that
+// subsequent calls for the same key share the retained result. A TTL of zero
+// (the default) only deduplicates concurrent calls.
+func WithTTL(ttl time.Duration) Option {
+	return optionFunc(func(dst *options) {
+		dst.TTL = ttl
+	})
+}
+
+type options struct {
+	Executor        Executor
+	TTL             time.Duration
+	CancelRemaining bool
+}
+
+func (o options) With(opts ...Option) options {
+	for _, opt := range opts {
+		opt.apply(&o)
+	}
+	return o
+}
+
+type optionFunc func(*options)
+
+func (f optionFunc) apply(dst *options) {
+	f(dst)
+}
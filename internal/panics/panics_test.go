@@ -0,0 +1,53 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package panics_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/internal/panics"
+)
+
+func TestRecover(t *testing.T) {
+	call := func(fn func() error) (err error) {
+		defer panics.Recover(&err)
+		return fn()
+	}
+
+	wantErr := errors.New(t.Name())
+	require.NoError(t, call(func() error { return nil }))
+	require.ErrorIs(t, call(func() error { return wantErr }), wantErr)
+
+	err := call(func() error { panic("oh no") })
+	var perr *panics.Error
+	require.ErrorAs(t, err, &perr)
+	require.Equal(t, "oh no", perr.Value)
+	require.Contains(t, string(perr.Stack), "TestRecover")
+	require.Equal(t, "panic: oh no", perr.Error())
+	require.NoError(t, perr.Unwrap())
+
+	err = call(func() error { panic(wantErr) })
+	require.ErrorAs(t, err, &perr)
+	require.ErrorIs(t, err, wantErr)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package result
+
+// Map returns a [Result] holding the result of calling fn with r's value, if r
+// holds a value. Otherwise, r's error (if any) is propagated and fn is not
+// called.
+func Map[T any, U any](r Result[T], fn func(T) U) Result[U] {
+	switch r.flag {
+	case _value:
+		return Ok(fn(r.value))
+	case _err:
+		return Err[U](r.err)
+	default:
+		return Result[U]{}
+	}
+}
+
+// FlatMap returns the [Result] produced by calling fn with r's value, if r
+// holds a value. Otherwise, r's error (if any) is propagated and fn is not
+// called.
+func FlatMap[T any, U any](r Result[T], fn func(T) Result[U]) Result[U] {
+	switch r.flag {
+	case _value:
+		return fn(r.value)
+	case _err:
+		return Err[U](r.err)
+	default:
+		return Result[U]{}
+	}
+}
+
+// AndThen returns a [Result] constructed from the return values of calling fn
+// with r's value (as with [From]), if r holds a value. Otherwise, r's error
+// (if any) is propagated and fn is not called. This allows fallible functions
+// (e.g. a slices.TransformErrorFunc) to be chained without leaving the
+// [Result] type.
+func AndThen[T any, U any](r Result[T], fn func(T) (U, error)) Result[U] {
+	return FlatMap(r, func(x T) Result[U] {
+		return From(fn(x))
+	})
+}
+
+// MapErr returns a [Result] holding the result of calling fn with r's error,
+// if r holds an error. Otherwise, r is returned unchanged and fn is not
+// called.
+func MapErr[T any](r Result[T], fn func(error) error) Result[T] {
+	if r.flag != _err {
+		return r
+	}
+	return Err[T](fn(r.err))
+}
+
+// OrElse returns the [Result] produced by calling fn with r's error, if r
+// holds an error. Otherwise, r is returned unchanged and fn is not called.
+func OrElse[T any](r Result[T], fn func(error) Result[T]) Result[T] {
+	if r.flag != _err {
+		return r
+	}
+	return fn(r.err)
+}
+
+// Collect returns a [Result] holding the values of each of the given results,
+// in order, if they all hold values. Otherwise, the returned result holds the
+// error of the first result that does not hold a value; if that result holds
+// neither a value nor an error, the returned result is also empty.
+func Collect[T any](results []Result[T]) Result[[]T] {
+	values := make([]T, len(results))
+	for i := range results {
+		switch results[i].flag {
+		case _value:
+			values[i] = results[i].value
+		case _err:
+			return Err[[]T](results[i].err)
+		default:
+			return Result[[]T]{}
+		}
+	}
+	return Ok(values)
+}
+
+// Partition splits the given results into the values and errors that they
+// hold, preserving order within each. Results that hold neither a value nor an
+// error are omitted.
+func Partition[T any](results []Result[T]) ([]T, []error) {
+	var (
+		values []T
+		errs   []error
+	)
+	for i := range results {
+		switch results[i].flag {
+		case _value:
+			values = append(values, results[i].value)
+		case _err:
+			errs = append(errs, results[i].err)
+		default:
+		}
+	}
+	return values, errs
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package result_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/result"
+)
+
+func TestMap(t *testing.T) {
+	wantErr := errors.New(t.Name())
+
+	r := result.Map(result.Ok(123), strconv.Itoa)
+	requireValue(t, "123", r)
+
+	r = result.Map(result.Err[int](wantErr), func(int) string {
+		require.FailNow(t, "unexpected call")
+		return ""
+	})
+	requireErr(t, wantErr, r)
+
+	r = result.Map(result.Result[int]{}, strconv.Itoa)
+	require.False(t, r.HasValue())
+	require.False(t, r.HasErr())
+}
+
+func TestFlatMap(t *testing.T) {
+	var (
+		wantErr = errors.New(t.Name())
+		half    = func(x int) result.Result[int] {
+			if x%2 != 0 {
+				return result.Err[int](wantErr)
+			}
+			return result.Ok(x / 2)
+		}
+	)
+
+	requireValue(t, 2, result.FlatMap(result.Ok(4), half))
+	requireErr(t, wantErr, result.FlatMap(result.Ok(3), half))
+	requireErr(t, wantErr, result.FlatMap(result.Err[int](wantErr), half))
+
+	r := result.FlatMap(result.Result[int]{}, half)
+	require.False(t, r.HasValue())
+	require.False(t, r.HasErr())
+}
+
+func TestAndThen(t *testing.T) {
+	r := result.AndThen(result.Ok("123"), strconv.Atoi)
+	requireValue(t, 123, r)
+
+	r = result.AndThen(result.Ok("abc"), strconv.Atoi)
+	require.True(t, r.HasErr())
+	e, _ := r.Err()
+	require.ErrorIs(t, e, strconv.ErrSyntax)
+}
+
+func TestMapErr(t *testing.T) {
+	var (
+		errA = errors.New("a")
+		wrap = func(err error) error {
+			return errors.Join(errors.New("wrapped"), err)
+		}
+	)
+
+	r := result.MapErr(result.Err[int](errA), wrap)
+	e, ok := r.Err()
+	require.True(t, ok)
+	require.ErrorIs(t, e, errA)
+	require.Contains(t, e.Error(), "wrapped")
+
+	requireValue(t, 123, result.MapErr(result.Ok(123), wrap))
+}
+
+func TestOrElse(t *testing.T) {
+	var (
+		errA     = errors.New("a")
+		fallback = func(error) result.Result[int] {
+			return result.Ok(456)
+		}
+	)
+
+	requireValue(t, 456, result.OrElse(result.Err[int](errA), fallback))
+	requireValue(t, 123, result.OrElse(result.Ok(123), fallback))
+}
+
+func TestCollect(t *testing.T) {
+	var (
+		errA = errors.New("a")
+		errB = errors.New("b")
+	)
+
+	requireValue(t, []int{1, 2, 3}, result.Collect([]result.Result[int]{
+		result.Ok(1),
+		result.Ok(2),
+		result.Ok(3),
+	}))
+	requireValue(t, []int{}, result.Collect[int](nil))
+
+	requireErr(t, errA, result.Collect([]result.Result[int]{
+		result.Ok(1),
+		result.Err[int](errA),
+		result.Err[int](errB),
+	}))
+
+	r := result.Collect([]result.Result[int]{
+		result.Ok(1),
+		{},
+		result.Err[int](errB),
+	})
+	require.False(t, r.HasValue())
+	require.False(t, r.HasErr())
+}
+
+func TestPartition(t *testing.T) {
+	var (
+		errA = errors.New("a")
+		errB = errors.New("b")
+	)
+
+	values, errs := result.Partition([]result.Result[int]{
+		result.Ok(1),
+		result.Err[int](errA),
+		{},
+		result.Ok(2),
+		result.Err[int](errB),
+	})
+	require.Equal(t, []int{1, 2}, values)
+	require.Equal(t, []error{errA, errB}, errs)
+
+	values, errs = result.Partition[int](nil)
+	require.Nil(t, values)
+	require.Nil(t, errs)
+}
+
+func requireValue[T any](t *testing.T, want T, r result.Result[T]) {
+	t.Helper()
+	have, ok := r.Value()
+	require.True(t, ok)
+	require.Equal(t, want, have)
+}
+
+func requireErr[T any](t *testing.T, want error, r result.Result[T]) {
+	t.Helper()
+	have, ok := r.Err()
+	require.True(t, ok)
+	require.ErrorIs(t, have, want)
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package result
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	_ json.Marshaler         = Result[int]{}
+	_ json.Unmarshaler       = (*Result[int])(nil)
+	_ encoding.TextMarshaler = Result[int]{}
+
+	// ErrInvalidJSON is returned when unmarshaling a [Result] from JSON that
+	// is not a valid result envelope.
+	ErrInvalidJSON = errors.New("invalid result JSON")
+
+	_codes = errorCodes{
+		byCode: make(map[string]error),
+	}
+)
+
+// RegisterErrorCode registers err under the given code, so that results
+// holding errors that match err (per [errors.Is]) are marshaled with the code
+// and unmarshal to errors that match err. RegisterErrorCode panics if code is
+// empty, err is nil, or code is already registered to a different error.
+func RegisterErrorCode(code string, err error) {
+	if len(code) == 0 || err == nil {
+		panic("result: RegisterErrorCode requires a code and an error")
+	}
+	_codes.register(code, err)
+}
+
+// MarshalJSON marshals the result as a JSON object holding either a "value"
+// key with the marshaled value, or an "error" key with the error's message
+// (and a "code" key, if the error matches one registered with
+// [RegisterErrorCode]). Results that hold neither are marshaled as an empty
+// object.
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	var env jsonEnvelope
+	switch r.flag {
+	case _value:
+		raw, err := json.Marshal(r.value)
+		if err != nil {
+			return nil, err
+		}
+		env.Value = raw
+	case _err:
+		msg, code := errorMessage(r.err), _codes.lookup(r.err)
+		env.Error = &msg
+		env.Code = code
+	default:
+	}
+	return json.Marshal(env)
+}
+
+// UnmarshalJSON unmarshals a result previously marshaled by
+// [Result.MarshalJSON]. Unmarshaled errors preserve the original error's
+// message; if the error was marshaled with a code registered with
+// [RegisterErrorCode], the unmarshaled error also matches the registered
+// error per [errors.Is].
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	var env jsonEnvelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+
+	switch {
+	case env.Value != nil && env.Error != nil:
+		return fmt.Errorf("%w: both value and error present", ErrInvalidJSON)
+	case env.Value != nil:
+		var value T
+		if err := json.Unmarshal(env.Value, &value); err != nil {
+			return err
+		}
+		*r = Ok(value)
+	case env.Error != nil:
+		*r = Err[T](&decodedError{
+			msg:    *env.Error,
+			code:   env.Code,
+			target: _codes.get(env.Code),
+		})
+	default:
+		*r = Result[T]{}
+	}
+	return nil
+}
+
+// MarshalText marshals the result as text. Results holding a value marshal
+// the value using its [encoding.TextMarshaler] implementation if it has one,
+// or its default format otherwise; results holding an error marshal as
+// "error: " followed by the error's message. Results that hold neither
+// marshal as empty text.
+func (r Result[T]) MarshalText() ([]byte, error) {
+	switch r.flag {
+	case _value:
+		if m, ok := any(r.value).(encoding.TextMarshaler); ok {
+			return m.MarshalText()
+		}
+		return fmt.Appendf(nil, "%v", r.value), nil
+	case _err:
+		return fmt.Appendf(nil, "error: %s", errorMessage(r.err)), nil
+	default:
+		return []byte{}, nil
+	}
+}
+
+type jsonEnvelope struct {
+	Error *string         `json:"error,omitempty"`
+	Code  string          `json:"code,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// A decodedError is an error that was unmarshaled from a [Result]'s JSON
+// representation.
+type decodedError struct {
+	target error
+	msg    string
+	code   string
+}
+
+func (e *decodedError) Error() string {
+	return e.msg
+}
+
+func (e *decodedError) Unwrap() error {
+	return e.target
+}
+
+type errorCodes struct {
+	byCode map[string]error
+	order  []string
+	mu     sync.RWMutex
+}
+
+func (c *errorCodes) register(code string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if prev, ok := c.byCode[code]; ok {
+		if prev != err {
+			panic(fmt.Sprintf(
+				"result: error code %q already registered",
+				code,
+			))
+		}
+		return
+	}
+
+	c.byCode[code] = err
+	c.order = append(c.order, code)
+}
+
+func (c *errorCodes) get(code string) error {
+	if len(code) == 0 {
+		return nil
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.byCode[code]
+}
+
+func (c *errorCodes) lookup(err error) string {
+	var decoded *decodedError
+	if errors.As(err, &decoded) && len(decoded.code) > 0 {
+		return decoded.code
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, code := range c.order {
+		if errors.Is(err, c.byCode[code]) {
+			return code
+		}
+	}
+	return ""
+}
+
+func errorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package result_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/result"
+)
+
+var errRegistered = errors.New("registered sentinel")
+
+func init() {
+	result.RegisterErrorCode("registered", errRegistered)
+	result.RegisterErrorCode("eof", io.EOF)
+}
+
+func TestResult_MarshalJSON(t *testing.T) {
+	type point struct {
+		X int `json:"x"`
+		Y int `json:"y"`
+	}
+
+	cases := map[string]struct {
+		give result.Result[*point]
+		want string
+	}{
+		"value": {
+			give: result.Ok(&point{X: 1, Y: 2}),
+			want: `{"value":{"x":1,"y":2}}`,
+		},
+		"nil value": {
+			give: result.Ok[*point](nil),
+			want: `{"value":null}`,
+		},
+		"error": {
+			give: result.Err[*point](errors.New("sadness")),
+			want: `{"error":"sadness"}`,
+		},
+		"empty error": {
+			give: result.Err[*point](errors.New("")),
+			want: `{"error":""}`,
+		},
+		"registered error": {
+			give: result.Err[*point](errors.Join(errRegistered)),
+			want: `{"error":"registered sentinel","code":"registered"}`,
+		},
+		"empty": {
+			give: result.Result[*point]{},
+			want: `{}`,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			raw, err := json.Marshal(tt.give)
+			require.NoError(t, err)
+			require.JSONEq(t, tt.want, string(raw))
+
+			var have result.Result[*point]
+			require.NoError(t, json.Unmarshal(raw, &have))
+			require.Equal(t, tt.give.HasValue(), have.HasValue())
+			require.Equal(t, tt.give.HasErr(), have.HasErr())
+
+			wantValue, wantErr := tt.give.Unwrap()
+			haveValue, haveErr := have.Unwrap()
+			require.Equal(t, wantValue, haveValue)
+			if wantErr != nil {
+				require.EqualError(t, haveErr, wantErr.Error())
+			}
+		})
+	}
+}
+
+func TestResult_UnmarshalJSON_RegisteredErrors(t *testing.T) {
+	type status struct {
+		Result result.Result[int] `json:"result"`
+	}
+
+	raw, err := json.Marshal(status{
+		Result: result.Err[int](errRegistered),
+	})
+	require.NoError(t, err)
+
+	var have status
+	require.NoError(t, json.Unmarshal(raw, &have))
+	e, ok := have.Result.Err()
+	require.True(t, ok)
+	require.ErrorIs(t, e, errRegistered)
+
+	// Registered codes are found through wrapping, and the wrapped message
+	// is preserved.
+	wrapped := &net.OpError{Op: "read", Net: "tcp", Err: io.EOF}
+	raw, err = json.Marshal(result.Err[int](wrapped))
+	require.NoError(t, err)
+
+	var r result.Result[int]
+	require.NoError(t, json.Unmarshal(raw, &r))
+	e, _ = r.Err()
+	require.ErrorIs(t, e, io.EOF)
+	require.EqualError(t, e, wrapped.Error())
+
+	// Unknown codes still round-trip.
+	require.NoError(t, json.Unmarshal(
+		[]byte(`{"error":"x","code":"unknown"}`),
+		&r,
+	))
+	raw, err = json.Marshal(r)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"error":"x","code":"unknown"}`, string(raw))
+}
+
+func TestResult_UnmarshalJSON_Invalid(t *testing.T) {
+	cases := map[string]string{
+		"not an object": `[1, 2, 3]`,
+		"value and err": `{"value": 1, "error": "x"}`,
+	}
+
+	for name, give := range cases {
+		t.Run(name, func(t *testing.T) {
+			var r result.Result[int]
+			require.ErrorIs(
+				t,
+				json.Unmarshal([]byte(give), &r),
+				result.ErrInvalidJSON,
+			)
+		})
+	}
+
+	var r result.Result[int]
+	require.ErrorIs(
+		t,
+		r.UnmarshalJSON([]byte(`{"value":`)),
+		result.ErrInvalidJSON,
+	)
+	require.Error(t, json.Unmarshal([]byte(`{"value":"abc"}`), &r))
+}
+
+func TestRegisterErrorCode(t *testing.T) {
+	require.NotPanics(t, func() {
+		result.RegisterErrorCode("registered", errRegistered)
+	})
+	require.Panics(t, func() {
+		result.RegisterErrorCode("registered", errors.New("other"))
+	})
+	require.Panics(t, func() {
+		result.RegisterErrorCode("", errRegistered)
+	})
+	require.Panics(t, func() {
+		result.RegisterErrorCode("nil", nil)
+	})
+}
+
+func TestResult_MarshalText(t *testing.T) {
+	ip := net.ParseIP("127.0.0.1")
+
+	cases := map[string]struct {
+		give interface{ MarshalText() ([]byte, error) }
+		want string
+	}{
+		"value": {
+			give: result.Ok(123),
+			want: "123",
+		},
+		"text marshaler": {
+			give: result.Ok(ip),
+			want: "127.0.0.1",
+		},
+		"error": {
+			give: result.Err[int](errors.New("sadness")),
+			want: "error: sadness",
+		},
+		"empty": {
+			give: result.Result[int]{},
+			want: "",
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			have, err := tt.give.MarshalText()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, string(have))
+		})
+	}
+}
@@ -21,6 +21,10 @@
 // Package result provides a helpful Result[T,error] type wrapper.
 package result
 
+import (
+	"go.mway.dev/x/internal/panics"
+)
+
 const (
 	_value = 1
 	_err   = 2
@@ -49,6 +53,23 @@ func Err[T any](err error) Result[T] {
 	}
 }
 
+// From constructs a [Result] from a (T, error) pair, like those returned by
+// fallible functions. If err is non-nil, the result holds err; otherwise, it
+// holds value.
+func From[T any](value T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(value)
+}
+
+// Try calls fn and constructs a [Result] from its return values as with
+// [From]. If fn panics, the panic is recovered and the result holds a
+// [*PanicError].
+func Try[T any](fn func() (T, error)) Result[T] {
+	return From(try(fn))
+}
+
 // HasValue returns whether the result holds a value.
 func (r *Result[T]) HasValue() bool {
 	return r.flag == _value
@@ -117,3 +138,18 @@ func (r *Result[T]) ErrOrElse(fn func() error) error {
 	}
 	return x
 }
+
+// Unwrap returns the result's held value and error as a (T, error) pair. If
+// the result holds neither, both returns are zero values.
+func (r *Result[T]) Unwrap() (T, error) {
+	return r.value, r.err
+}
+
+// A PanicError is held by a [Result] produced by [Try] when the given function
+// panics.
+type PanicError = panics.Error
+
+func try[T any](fn func() (T, error)) (value T, err error) {
+	defer panics.Recover(&err)
+	return fn()
+}
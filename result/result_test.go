@@ -82,3 +82,55 @@ func TestErr(t *testing.T) {
 	require.False(t, ok)
 	require.Zero(t, v)
 }
+
+func TestFrom(t *testing.T) {
+	r := result.From(123, nil)
+	require.True(t, r.HasValue())
+	v, err := r.Unwrap()
+	require.NoError(t, err)
+	require.Equal(t, 123, v)
+
+	wantErr := errors.New(t.Name())
+	r = result.From(123, wantErr)
+	require.True(t, r.HasErr())
+	v, err = r.Unwrap()
+	require.ErrorIs(t, err, wantErr)
+	require.Zero(t, v)
+}
+
+func TestUnwrap_ZeroValue(t *testing.T) {
+	var r result.Result[int]
+	v, err := r.Unwrap()
+	require.NoError(t, err)
+	require.Zero(t, v)
+}
+
+func TestTry(t *testing.T) {
+	r := result.Try(func() (int, error) {
+		return 123, nil
+	})
+	v, ok := r.Value()
+	require.True(t, ok)
+	require.Equal(t, 123, v)
+
+	wantErr := errors.New(t.Name())
+	r = result.Try(func() (int, error) {
+		return 0, wantErr
+	})
+	require.ErrorIs(t, r.ErrOr(nil), wantErr)
+
+	r = result.Try(func() (int, error) {
+		panic("oh no")
+	})
+	var perr *result.PanicError
+	require.ErrorAs(t, r.ErrOr(nil), &perr)
+	require.Equal(t, "oh no", perr.Value)
+	require.Equal(t, "panic: oh no", perr.Error())
+	require.NotEmpty(t, perr.Stack)
+	require.NoError(t, perr.Unwrap())
+
+	r = result.Try(func() (int, error) {
+		panic(wantErr)
+	})
+	require.ErrorIs(t, r.ErrOr(nil), wantErr)
+}
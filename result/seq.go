@@ -0,0 +1,93 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package result
+
+import (
+	"errors"
+	"iter"
+)
+
+// FromSeq2 adapts seq into a sequence of [Result] values, as with [From].
+func FromSeq2[T any](seq iter.Seq2[T, error]) iter.Seq[Result[T]] {
+	return func(yield func(Result[T]) bool) {
+		for value, err := range seq {
+			if !yield(From(value, err)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence that yields the values held by the results in
+// seq, stopping at the first result that holds an error. The returned
+// function reports that error, if any, once iteration has stopped. Results
+// that hold neither a value nor an error are skipped.
+func Values[T any](seq iter.Seq[Result[T]]) (iter.Seq[T], func() error) {
+	var err error
+	values := func(yield func(T) bool) {
+		err = nil
+		for r := range seq {
+			switch r.flag {
+			case _value:
+				if !yield(r.value) {
+					return
+				}
+			case _err:
+				err = r.err
+				return
+			default:
+			}
+		}
+	}
+	return values, func() error { return err }
+}
+
+// SkipErrors returns a sequence that yields the values held by the results in
+// seq, skipping any results that do not hold a value.
+func SkipErrors[T any](seq iter.Seq[Result[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for r := range seq {
+			if r.flag == _value && !yield(r.value) {
+				return
+			}
+		}
+	}
+}
+
+// CollectErrors consumes seq, returning the values held by its results in
+// order along with an error that joins all of the errors held by its results
+// (see [errors.Join]). The returned error is nil if no result holds an error.
+func CollectErrors[T any](seq iter.Seq[Result[T]]) ([]T, error) {
+	var (
+		values []T
+		errs   []error
+	)
+	for r := range seq {
+		switch r.flag {
+		case _value:
+			values = append(values, r.value)
+		case _err:
+			errs = append(errs, r.err)
+		default:
+		}
+	}
+	return values, errors.Join(errs...)
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package result_test
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/result"
+)
+
+func TestFromSeq2(t *testing.T) {
+	var (
+		errA = errors.New("a")
+		seq  = func(yield func(int, error) bool) {
+			_ = yield(1, nil) && yield(0, errA) && yield(3, nil)
+		}
+	)
+
+	have := slices.Collect(result.FromSeq2(seq))
+	require.Len(t, have, 3)
+	requireValue(t, 1, have[0])
+	requireErr(t, errA, have[1])
+	requireValue(t, 3, have[2])
+
+	for r := range result.FromSeq2(seq) {
+		requireValue(t, 1, r)
+		break
+	}
+}
+
+func TestValues(t *testing.T) {
+	errA := errors.New("a")
+
+	values, errf := result.Values(slices.Values([]result.Result[int]{
+		result.Ok(1),
+		{},
+		result.Ok(2),
+		result.Err[int](errA),
+		result.Ok(3),
+	}))
+	require.Equal(t, []int{1, 2}, slices.Collect(values))
+	require.ErrorIs(t, errf(), errA)
+
+	values, errf = result.Values(slices.Values([]result.Result[int]{
+		result.Ok(1),
+		result.Ok(2),
+	}))
+	require.Equal(t, []int{1, 2}, slices.Collect(values))
+	require.NoError(t, errf())
+
+	for x := range values {
+		require.Equal(t, 1, x)
+		break
+	}
+	require.NoError(t, errf())
+}
+
+func TestSkipErrors(t *testing.T) {
+	seq := result.FromSeq2(func(yield func(int, error) bool) {
+		for _, s := range []string{"1", "x", "2", "y", "3"} {
+			if !yield(strconv.Atoi(s)) {
+				return
+			}
+		}
+	})
+
+	require.Equal(t, []int{1, 2, 3}, slices.Collect(result.SkipErrors(seq)))
+
+	for x := range result.SkipErrors(seq) {
+		require.Equal(t, 1, x)
+		break
+	}
+}
+
+func TestCollectErrors(t *testing.T) {
+	var (
+		errA = errors.New("a")
+		errB = errors.New("b")
+	)
+
+	values, err := result.CollectErrors(slices.Values([]result.Result[int]{
+		result.Ok(1),
+		result.Err[int](errA),
+		{},
+		result.Ok(2),
+		result.Err[int](errB),
+	}))
+	require.Equal(t, []int{1, 2}, values)
+	require.ErrorIs(t, err, errA)
+	require.ErrorIs(t, err, errB)
+
+	values, err = result.CollectErrors(slices.Values([]result.Result[int]{
+		result.Ok(1),
+	}))
+	require.Equal(t, []int{1}, values)
+	require.NoError(t, err)
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sampling
+
+import (
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// _adaptiveBuckets is the number of buckets that an [AdaptiveSampler]'s
+// window is divided into.
+const _adaptiveBuckets = 10
+
+var _ Sampler = (*AdaptiveSampler)(nil)
+
+// An AdaptiveSampler adjusts its sampling probability to admit approximately
+// a target number of events per second, based on the rate of events observed
+// over a sliding window. An AdaptiveSampler is safe for concurrent use.
+type AdaptiveSampler struct {
+	start   time.Time
+	rng     *rand.Rand
+	buckets [_adaptiveBuckets]uint64
+	width   time.Duration
+	target  float64
+	seen    uint64
+	cur     int64
+	mu      sync.Mutex
+}
+
+// NewAdaptiveSampler creates a new [AdaptiveSampler] that targets perSecond
+// sampled events per second, observed over the given window. Until more than
+// the target number of events are observed in a window, all events are
+// sampled.
+func NewAdaptiveSampler(
+	perSecond float64,
+	window time.Duration,
+	opts ...Option,
+) *AdaptiveSampler {
+	return &AdaptiveSampler{
+		start:  _now(),
+		rng:    options{}.With(opts...).rand(),
+		width:  max(window/_adaptiveBuckets, 1),
+		target: max(perSecond, 0) * window.Seconds(),
+	}
+}
+
+// Try reports whether the current event should be sampled.
+func (s *AdaptiveSampler) Try() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.advanceUnsafe()
+	s.buckets[s.cur%_adaptiveBuckets]++
+	s.seen++
+
+	p := s.probabilityUnsafe()
+	return p >= 1 || s.rng.Float64() < p
+}
+
+// Probability returns s's current sampling probability.
+func (s *AdaptiveSampler) Probability() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.advanceUnsafe()
+	return s.probabilityUnsafe()
+}
+
+// advanceUnsafe expires any buckets that have left the window.
+func (s *AdaptiveSampler) advanceUnsafe() {
+	cur := int64(_now().Sub(s.start) / s.width)
+	if cur <= s.cur {
+		return
+	}
+
+	// n.b. Only the buckets between the previous and current positions have
+	//      expired, up to the whole window.
+	for i := s.cur + 1; i <= cur && i <= s.cur+_adaptiveBuckets; i++ {
+		bucket := &s.buckets[i%_adaptiveBuckets]
+		s.seen -= *bucket
+		*bucket = 0
+	}
+	s.cur = cur
+}
+
+func (s *AdaptiveSampler) probabilityUnsafe() float64 {
+	if s.seen == 0 {
+		return 1
+	}
+	return min(1, s.target/float64(s.seen))
+}
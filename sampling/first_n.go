@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sampling
+
+import (
+	"sync/atomic"
+)
+
+var _ Sampler = (*FirstNSampler)(nil)
+
+// A FirstNSampler deterministically samples the first N events it sees, and
+// then every Mth event thereafter. A FirstNSampler is safe for concurrent use.
+type FirstNSampler struct {
+	n          atomic.Uint64
+	first      uint64
+	thereafter uint64
+}
+
+// NewFirstNSampler creates a new [FirstNSampler] that samples the first first
+// events, and then one in every thereafter events. If thereafter is zero, no
+// events are sampled after the first first events.
+func NewFirstNSampler(first uint64, thereafter uint64) *FirstNSampler {
+	return &FirstNSampler{
+		first:      first,
+		thereafter: thereafter,
+	}
+}
+
+// Try reports whether the current event should be sampled.
+func (s *FirstNSampler) Try() bool {
+	n := s.n.Add(1)
+	if n <= s.first {
+		return true
+	}
+	return s.thereafter > 0 && (n-s.first)%s.thereafter == 0
+}
+
+// Reset resets s's count of events seen, such that the next first events are
+// sampled again.
+func (s *FirstNSampler) Reset() {
+	s.n.Store(0)
+}
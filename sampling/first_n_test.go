@@ -0,0 +1,55 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sampling_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/sampling"
+)
+
+func TestFirstNSampler(t *testing.T) {
+	var (
+		s    = sampling.NewFirstNSampler(3, 4)
+		have []int
+	)
+
+	for i := range 16 {
+		if s.Try() {
+			have = append(have, i)
+		}
+	}
+	require.Equal(t, []int{0, 1, 2, 6, 10, 14}, have)
+
+	s.Reset()
+	require.True(t, s.Try())
+}
+
+func TestFirstNSampler_NoneThereafter(t *testing.T) {
+	s := sampling.NewFirstNSampler(2, 0)
+	require.True(t, s.Try())
+	require.True(t, s.Try())
+	for range 100 {
+		require.False(t, s.Try())
+	}
+}
@@ -22,7 +22,8 @@
 package sampling
 
 import (
-	_ "unsafe" // go:linkname
+	"hash/fnv"
+	"math/rand/v2"
 )
 
 const (
@@ -32,16 +33,49 @@ const (
 	Off = Gate(0.0)
 	// Coin is a Gate that flips a coin (i.e., 50/50).
 	Coin = Gate(0.5)
+
+	_gateBits = 24
+	_gateMax  = 1 << _gateBits
 )
 
+// A Sampler decides whether individual events should be sampled.
+type Sampler interface {
+	// Try reports whether the current event should be sampled.
+	Try() bool
+}
+
+var _ Sampler = Gate(0)
+
 // Gate is a simple sampling gate in the range [0.0, 1.0].
 type Gate float64
 
 // Try makes an attempt against g's inherent probability.
 func (g Gate) Try() bool {
-	const _max = 1 << 24
-	return fastrandn(_max) >= _max-uint32(g*_max)
+	return g.admit(rand.Uint32N(_gateMax))
 }
 
-//go:linkname fastrandn runtime.fastrandn
-func fastrandn(uint32) uint32
+// TryKey makes a deterministic attempt against g's inherent probability for
+// the given key: the same key always yields the same result for a given Gate,
+// including across processes. This is useful for consistently sampling
+// entities such as traces or requests that are observed in multiple places.
+func (g Gate) TryKey(key string) bool {
+	h := fnv.New64a()
+	h.Write([]byte(key)) //nolint:errcheck
+	return g.admit(uint32(mix64(h.Sum64()) >> (64 - _gateBits)))
+}
+
+// admit reports whether n, which must be in [0, _gateMax), falls within g.
+func (g Gate) admit(n uint32) bool {
+	return n >= _gateMax-uint32(g*_gateMax)
+}
+
+// mix64 is the SplitMix64 finalizer, which distributes the bits of FNV hashes
+// of similar keys more evenly.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
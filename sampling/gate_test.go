@@ -21,6 +21,7 @@
 package sampling_test
 
 import (
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -60,3 +61,42 @@ func TestGate_Coin_Try(t *testing.T) {
 
 	require.InDelta(t, 500_000, heads, 100_000)
 }
+
+func TestGate_TryKey(t *testing.T) {
+	var (
+		heads int
+		keys  = make([]string, 100_000)
+	)
+
+	for i := range keys {
+		keys[i] = "trace-" + strconv.Itoa(i)
+		if sampling.Coin.TryKey(keys[i]) {
+			heads++
+		}
+	}
+
+	require.InDelta(t, 50_000, heads, 2_000)
+
+	// The same key always yields the same result.
+	for _, key := range keys[:1000] {
+		want := sampling.Coin.TryKey(key)
+		for range 10 {
+			require.Equal(t, want, sampling.Coin.TryKey(key))
+		}
+	}
+
+	// Keys sampled at a lower rate are also sampled at a higher rate.
+	for _, key := range keys {
+		if sampling.Gate(0.1).TryKey(key) {
+			require.True(t, sampling.Gate(0.2).TryKey(key))
+		}
+	}
+}
+
+func TestGate_TryKey_OnOff(t *testing.T) {
+	for i := range 10_000 {
+		key := strconv.Itoa(i)
+		require.True(t, sampling.On.TryKey(key))
+		require.False(t, sampling.Off.TryKey(key))
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sampling
+
+import (
+	"math/rand/v2"
+)
+
+// An Option configures samplers like [AdaptiveSampler].
+type Option interface {
+	apply(*options)
+}
+
+// WithSource returns a new [Option] that configures a sampler to draw from
+// src instead of a randomly-seeded source, such that its results are
+// reproducible when src is seeded. The sampler assumes exclusive use of src.
+func WithSource(src rand.Source) Option {
+	return optionFunc(func(dst *options) {
+		dst.Source = src
+	})
+}
+
+type options struct {
+	Source rand.Source
+}
+
+func (o options) With(opts ...Option) options {
+	for _, opt := range opts {
+		opt.apply(&o)
+	}
+	return o
+}
+
+func (o options) rand() *rand.Rand {
+	src := o.Source
+	if src == nil {
+		src = rand.NewPCG(rand.Uint64(), rand.Uint64())
+	}
+	return rand.New(src) //nolint:gosec
+}
+
+type optionFunc func(*options)
+
+func (f optionFunc) apply(dst *options) {
+	f(dst)
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sampling
+
+import (
+	"sync"
+	"time"
+)
+
+// _now is the time source used by time-based samplers.
+var _now = time.Now
+
+var _ Sampler = (*RateSampler)(nil)
+
+// A RateSampler is a token-bucket sampler that admits up to a fixed number of
+// events per second, with bursts of up to a given size. A RateSampler is safe
+// for concurrent use.
+type RateSampler struct {
+	last   time.Time
+	rate   float64
+	burst  float64
+	tokens float64
+	mu     sync.Mutex
+}
+
+// NewRateSampler creates a new [RateSampler] that admits perSecond events per
+// second on average, and up to burst events at once. The bucket starts full.
+// A burst smaller than one is treated as one.
+func NewRateSampler(perSecond float64, burst int) *RateSampler {
+	b := float64(max(burst, 1))
+	return &RateSampler{
+		last:   _now(),
+		rate:   max(perSecond, 0),
+		burst:  b,
+		tokens: b,
+	}
+}
+
+// Try reports whether the current event should be sampled, consuming a token
+// if so.
+func (s *RateSampler) Try() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := _now()
+	if elapsed := now.Sub(s.last); elapsed > 0 {
+		s.tokens = min(s.burst, s.tokens+elapsed.Seconds()*s.rate)
+		s.last = now
+	}
+
+	if s.tokens < 1 {
+		return false
+	}
+	s.tokens--
+	return true
+}
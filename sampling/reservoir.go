@@ -0,0 +1,299 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sampling
+
+import (
+	"container/heap"
+	"iter"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sync"
+)
+
+// A Reservoir keeps a uniform random sample of up to k values from a stream
+// of unknown length. It uses Algorithm L, which yields the same distribution
+// as the classic Algorithm R but only draws random numbers when a value is
+// selected, rather than for every value.
+//
+// A Reservoir is not safe for concurrent use; see [ConcurrentReservoir].
+type Reservoir[T any] struct {
+	rng   *rand.Rand
+	items []T
+	w     float64
+	n     uint64
+	next  uint64
+	k     int
+}
+
+// NewReservoir creates a new [Reservoir] that keeps up to k values.
+func NewReservoir[T any](k int, opts ...Option) *Reservoir[T] {
+	k = max(k, 0)
+	return &Reservoir[T]{
+		rng:   options{}.With(opts...).rand(),
+		items: make([]T, 0, k),
+		k:     k,
+	}
+}
+
+// Add offers value to r.
+func (r *Reservoir[T]) Add(value T) {
+	r.n++
+
+	if len(r.items) < r.k {
+		r.items = append(r.items, value)
+		if len(r.items) == r.k {
+			r.w = math.Exp(math.Log(r.random()) / float64(r.k))
+			r.skip()
+		}
+		return
+	}
+
+	if r.k == 0 || r.n != r.next {
+		return
+	}
+
+	r.items[r.rng.IntN(r.k)] = value
+	r.w *= math.Exp(math.Log(r.random()) / float64(r.k))
+	r.skip()
+}
+
+// Samples returns a copy of the values currently held by r, in no particular
+// order.
+func (r *Reservoir[T]) Samples() []T {
+	return slices.Clone(r.items)
+}
+
+// Len returns the number of values currently held by r.
+func (r *Reservoir[T]) Len() int {
+	return len(r.items)
+}
+
+// Seen returns the number of values that have been offered to r.
+func (r *Reservoir[T]) Seen() uint64 {
+	return r.n
+}
+
+// Reset discards all values held by r.
+func (r *Reservoir[T]) Reset() {
+	clear(r.items)
+	r.items = r.items[:0]
+	r.w = 0
+	r.n = 0
+	r.next = 0
+}
+
+// random returns a random number in (0, 1].
+func (r *Reservoir[T]) random() float64 {
+	return 1 - r.rng.Float64()
+}
+
+// skip determines the index of the next value to be selected.
+func (r *Reservoir[T]) skip() {
+	skip := math.Floor(math.Log(r.random()) / math.Log(1-r.w))
+	if skip >= math.MaxUint64-float64(r.n) {
+		r.next = math.MaxUint64
+		return
+	}
+	r.next = r.n + uint64(skip) + 1
+}
+
+// A ConcurrentReservoir is a [Reservoir] that is safe for concurrent use.
+type ConcurrentReservoir[T any] struct {
+	r  *Reservoir[T]
+	mu sync.Mutex
+}
+
+// NewConcurrentReservoir creates a new [ConcurrentReservoir] that keeps up to
+// k values.
+func NewConcurrentReservoir[T any](
+	k int,
+	opts ...Option,
+) *ConcurrentReservoir[T] {
+	return &ConcurrentReservoir[T]{
+		r: NewReservoir[T](k, opts...),
+	}
+}
+
+// Add offers value to r.
+func (r *ConcurrentReservoir[T]) Add(value T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.r.Add(value)
+}
+
+// Samples returns a copy of the values currently held by r, in no particular
+// order.
+func (r *ConcurrentReservoir[T]) Samples() []T {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.r.Samples()
+}
+
+// Len returns the number of values currently held by r.
+func (r *ConcurrentReservoir[T]) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.r.Len()
+}
+
+// Seen returns the number of values that have been offered to r.
+func (r *ConcurrentReservoir[T]) Seen() uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.r.Seen()
+}
+
+// Reset discards all values held by r.
+func (r *ConcurrentReservoir[T]) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.r.Reset()
+}
+
+// A WeightedReservoir keeps a weighted random sample of up to k values from a
+// stream of unknown length, such that each value's chance of being selected
+// is proportional to its weight. It uses the A-ES algorithm of Efraimidis and
+// Spirakis.
+//
+// A WeightedReservoir is not safe for concurrent use.
+type WeightedReservoir[T any] struct {
+	rng   *rand.Rand
+	items weightedItems[T]
+	n     uint64
+	k     int
+}
+
+// NewWeightedReservoir creates a new [WeightedReservoir] that keeps up to k
+// values.
+func NewWeightedReservoir[T any](k int, opts ...Option) *WeightedReservoir[T] {
+	k = max(k, 0)
+	return &WeightedReservoir[T]{
+		rng:   options{}.With(opts...).rand(),
+		items: make(weightedItems[T], 0, k),
+		k:     k,
+	}
+}
+
+// Add offers value to r with the given weight. Values with non-positive
+// weights are never selected.
+func (r *WeightedReservoir[T]) Add(value T, weight float64) {
+	r.n++
+	if r.k == 0 || !(weight > 0) {
+		return
+	}
+
+	// n.b. The A-ES key is u^(1/w); comparing log(u)/w instead preserves the
+	//      ordering while avoiding underflow for small weights.
+	item := weightedItem[T]{
+		value: value,
+		key:   math.Log(1-r.rng.Float64()) / weight,
+	}
+
+	switch {
+	case len(r.items) < r.k:
+		heap.Push(&r.items, item)
+	case item.key > r.items[0].key:
+		r.items[0] = item
+		heap.Fix(&r.items, 0)
+	}
+}
+
+// Samples returns a copy of the values currently held by r, in no particular
+// order.
+func (r *WeightedReservoir[T]) Samples() []T {
+	values := make([]T, len(r.items))
+	for i := range r.items {
+		values[i] = r.items[i].value
+	}
+	return values
+}
+
+// Len returns the number of values currently held by r.
+func (r *WeightedReservoir[T]) Len() int {
+	return len(r.items)
+}
+
+// Seen returns the number of values that have been offered to r.
+func (r *WeightedReservoir[T]) Seen() uint64 {
+	return r.n
+}
+
+// Reset discards all values held by r.
+func (r *WeightedReservoir[T]) Reset() {
+	clear(r.items)
+	r.items = r.items[:0]
+	r.n = 0
+}
+
+// SampleSeq returns a uniform random sample of up to k values from seq.
+func SampleSeq[T any](seq iter.Seq[T], k int, opts ...Option) []T {
+	r := NewReservoir[T](k, opts...)
+	for value := range seq {
+		r.Add(value)
+	}
+	return r.items
+}
+
+// SampleWeightedSeq returns a weighted random sample of up to k values from
+// seq, which yields each value with its weight.
+func SampleWeightedSeq[T any](
+	seq iter.Seq2[T, float64],
+	k int,
+	opts ...Option,
+) []T {
+	r := NewWeightedReservoir[T](k, opts...)
+	for value, weight := range seq {
+		r.Add(value, weight)
+	}
+	return r.Samples()
+}
+
+type weightedItem[T any] struct {
+	value T
+	key   float64
+}
+
+// weightedItems is a min-heap of items ordered by key.
+type weightedItems[T any] []weightedItem[T]
+
+func (h *weightedItems[T]) Len() int {
+	return len(*h)
+}
+
+func (h *weightedItems[T]) Less(i, j int) bool {
+	return (*h)[i].key < (*h)[j].key
+}
+
+func (h *weightedItems[T]) Swap(i, j int) {
+	(*h)[i], (*h)[j] = (*h)[j], (*h)[i]
+}
+
+func (h *weightedItems[T]) Push(x any) {
+	*h = append(*h, x.(weightedItem[T])) //nolint:errcheck
+}
+
+func (h *weightedItems[T]) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
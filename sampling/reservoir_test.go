@@ -0,0 +1,231 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sampling_test
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/sampling"
+)
+
+func TestReservoir(t *testing.T) {
+	r := sampling.NewReservoir[int](5, sampling.WithSource(rand.NewPCG(1, 2)))
+
+	for i := range 3 {
+		r.Add(i)
+	}
+	require.Equal(t, 3, r.Len())
+	require.Equal(t, []int{0, 1, 2}, r.Samples())
+
+	for i := 3; i < 1000; i++ {
+		r.Add(i)
+	}
+	require.Equal(t, 5, r.Len())
+	require.EqualValues(t, 1000, r.Seen())
+
+	samples := r.Samples()
+	require.Len(t, samples, 5)
+	require.Len(t, uniq(samples), 5)
+
+	r.Reset()
+	require.Zero(t, r.Len())
+	require.Zero(t, r.Seen())
+	require.Empty(t, r.Samples())
+}
+
+func TestReservoir_Zero(t *testing.T) {
+	r := sampling.NewReservoir[int](0)
+	for i := range 100 {
+		r.Add(i)
+	}
+	require.Zero(t, r.Len())
+	require.EqualValues(t, 100, r.Seen())
+}
+
+func TestReservoir_Uniform(t *testing.T) {
+	const (
+		n      = 100
+		k      = 10
+		trials = 20_000
+	)
+
+	var (
+		counts = make([]int, n)
+		src    = rand.NewPCG(1, 2)
+	)
+
+	for range trials {
+		sample := sampling.SampleSeq(
+			slices.Values(seq(n)),
+			k,
+			sampling.WithSource(src),
+		)
+		require.Len(t, sample, k)
+		for _, v := range sample {
+			counts[v]++
+		}
+	}
+
+	requireUniform(t, counts, float64(trials*k)/n)
+}
+
+func TestReservoir_UniformLongStream(t *testing.T) {
+	const (
+		n      = 10_000
+		k      = 10
+		trials = 2_000
+	)
+
+	// Bucket values by decile to verify that values from all parts of a
+	// long stream are selected uniformly.
+	var (
+		counts = make([]int, 10)
+		src    = rand.NewPCG(3, 4)
+	)
+
+	for range trials {
+		for _, v := range sampling.SampleSeq(
+			slices.Values(seq(n)),
+			k,
+			sampling.WithSource(src),
+		) {
+			counts[v*len(counts)/n]++
+		}
+	}
+
+	requireUniform(t, counts, float64(trials*k)/float64(len(counts)))
+}
+
+func TestConcurrentReservoir(t *testing.T) {
+	var (
+		r  = sampling.NewConcurrentReservoir[int](10)
+		wg sync.WaitGroup
+	)
+
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range 1000 {
+				r.Add(i*1000 + j)
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 10, r.Len())
+	require.EqualValues(t, 8000, r.Seen())
+	require.Len(t, uniq(r.Samples()), 10)
+
+	r.Reset()
+	require.Zero(t, r.Len())
+	require.Zero(t, r.Seen())
+}
+
+func TestWeightedReservoir(t *testing.T) {
+	r := sampling.NewWeightedReservoir[string](
+		2,
+		sampling.WithSource(rand.NewPCG(1, 2)),
+	)
+
+	r.Add("zero", 0)
+	r.Add("negative", -1)
+	require.Zero(t, r.Len())
+
+	r.Add("a", 1)
+	r.Add("b", 1)
+	r.Add("c", 1)
+	require.Equal(t, 2, r.Len())
+	require.EqualValues(t, 5, r.Seen())
+	require.Len(t, uniq(r.Samples()), 2)
+
+	r.Reset()
+	require.Zero(t, r.Len())
+	require.Zero(t, r.Seen())
+}
+
+func TestWeightedReservoir_Proportional(t *testing.T) {
+	const trials = 20_000
+
+	var (
+		weights = []float64{1, 2, 3, 4}
+		counts  = make([]int, len(weights))
+		src     = rand.NewPCG(1, 2)
+	)
+
+	for range trials {
+		sample := sampling.SampleWeightedSeq(
+			slices.All(weights),
+			1,
+			sampling.WithSource(src),
+		)
+		require.Len(t, sample, 1)
+		counts[sample[0]]++
+	}
+
+	for i, w := range weights {
+		require.InDelta(t, trials*w/10, counts[i], trials*0.02)
+	}
+}
+
+func seq(n int) []int {
+	values := make([]int, n)
+	for i := range values {
+		values[i] = i
+	}
+	return values
+}
+
+func uniq[T comparable](values []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	return set
+}
+
+// requireUniform requires that counts are consistent with a uniform
+// distribution using Pearson's chi-squared test at p=0.001.
+func requireUniform(t *testing.T, counts []int, expected float64) {
+	t.Helper()
+
+	var chi2 float64
+	for _, count := range counts {
+		d := float64(count) - expected
+		chi2 += d * d / expected
+	}
+
+	// Wilson-Hilferty approximation of the chi-squared critical value for
+	// len(counts)-1 degrees of freedom, with z=3.09 (p=0.001).
+	var (
+		df       = float64(len(counts) - 1)
+		a        = 2 / (9 * df)
+		x        = 1 - a + 3.09*math.Sqrt(a)
+		critical = df * x * x * x
+	)
+	require.Less(t, chi2, critical, "counts: %v", counts)
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sampling
+
+import (
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/stub"
+)
+
+func TestRateSampler(t *testing.T) {
+	now := time.Unix(0, 0)
+	stub.With(&_now, func() time.Time { return now }, func() {
+		s := NewRateSampler(10, 5)
+
+		// The bucket starts full.
+		require.Equal(t, 5, countTries(s, 100))
+
+		// Tokens refill at the configured rate.
+		now = now.Add(100 * time.Millisecond)
+		require.Equal(t, 1, countTries(s, 100))
+		now = now.Add(250 * time.Millisecond)
+		require.Equal(t, 2, countTries(s, 100))
+
+		// Tokens never exceed the burst size.
+		now = now.Add(time.Hour)
+		require.Equal(t, 5, countTries(s, 100))
+	})
+}
+
+func TestRateSampler_MinimumBurst(t *testing.T) {
+	now := time.Unix(0, 0)
+	stub.With(&_now, func() time.Time { return now }, func() {
+		s := NewRateSampler(1, 0)
+		require.Equal(t, 1, countTries(s, 100))
+		now = now.Add(time.Second)
+		require.Equal(t, 1, countTries(s, 100))
+	})
+}
+
+func TestAdaptiveSampler(t *testing.T) {
+	now := time.Unix(0, 0)
+	stub.With(&_now, func() time.Time { return now }, func() {
+		s := NewAdaptiveSampler(
+			100,
+			time.Second,
+			WithSource(rand.NewPCG(1, 2)),
+		)
+
+		// Everything is sampled until the target is exceeded.
+		require.Equal(t, 100, countTries(s, 100))
+		require.InDelta(t, 1.0, s.Probability(), 0)
+
+		// 1000 events per second, targeting 100 per second.
+		for range 100 {
+			now = now.Add(10 * time.Millisecond)
+			countTries(s, 10)
+		}
+		require.InDelta(t, 0.1, s.Probability(), 0.01)
+
+		// Sustained over multiple windows, throughput converges on the
+		// target.
+		var sampled int
+		for range 100 {
+			now = now.Add(100 * time.Millisecond)
+			sampled += countTries(s, 100)
+		}
+		require.InDelta(t, 1000, sampled, 100)
+
+		// Once events stop, the window drains and the probability recovers.
+		now = now.Add(2 * time.Second)
+		require.InDelta(t, 1.0, s.Probability(), 0)
+	})
+}
+
+func countTries(s Sampler, n int) int {
+	var count int
+	for range n {
+		if s.Try() {
+			count++
+		}
+	}
+	return count
+}
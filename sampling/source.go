@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sampling
+
+import (
+	"math/rand/v2"
+	"sync"
+)
+
+var _ Sampler = (*SourceGate)(nil)
+
+// A SourceGate is a [Gate] that draws from a specific [rand.Source], such
+// that its results are reproducible when the source is seeded. A SourceGate
+// is safe for concurrent use.
+type SourceGate struct {
+	rng  *rand.Rand
+	mu   sync.Mutex
+	gate Gate
+}
+
+// NewSourceGate creates a new [SourceGate] with the given probability that
+// draws from src.
+func NewSourceGate(g Gate, src rand.Source) *SourceGate {
+	return &SourceGate{
+		rng:  rand.New(src), //nolint:gosec
+		gate: g,
+	}
+}
+
+// NewPCGGate creates a new [SourceGate] with the given probability that draws
+// from a [rand.PCG] seeded with seed1 and seed2.
+func NewPCGGate(g Gate, seed1 uint64, seed2 uint64) *SourceGate {
+	return NewSourceGate(g, rand.NewPCG(seed1, seed2))
+}
+
+// NewChaCha8Gate creates a new [SourceGate] with the given probability that
+// draws from a [rand.ChaCha8] seeded with seed.
+func NewChaCha8Gate(g Gate, seed [32]byte) *SourceGate {
+	return NewSourceGate(g, rand.NewChaCha8(seed))
+}
+
+// Gate returns g's probability.
+func (g *SourceGate) Gate() Gate {
+	return g.gate
+}
+
+// Try makes an attempt against g's probability.
+func (g *SourceGate) Try() bool {
+	g.mu.Lock()
+	n := g.rng.Uint32N(_gateMax)
+	g.mu.Unlock()
+	return g.gate.admit(n)
+}
+
+// TryKey makes a deterministic attempt against g's probability for the given
+// key. It does not draw from g's source; see [Gate.TryKey].
+func (g *SourceGate) TryKey(key string) bool {
+	return g.gate.TryKey(key)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sampling_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/sampling"
+)
+
+func TestSourceGate_Reproducible(t *testing.T) {
+	cases := map[string]func() *sampling.SourceGate{
+		"pcg": func() *sampling.SourceGate {
+			return sampling.NewPCGGate(sampling.Coin, 1, 2)
+		},
+		"chacha8": func() *sampling.SourceGate {
+			return sampling.NewChaCha8Gate(sampling.Coin, [32]byte{1, 2, 3})
+		},
+	}
+
+	for name, newGate := range cases {
+		t.Run(name, func(t *testing.T) {
+			var (
+				a     = newGate()
+				b     = newGate()
+				heads int
+			)
+
+			for range 100_000 {
+				have := a.Try()
+				require.Equal(t, have, b.Try())
+				if have {
+					heads++
+				}
+			}
+
+			require.InDelta(t, 50_000, heads, 2_000)
+		})
+	}
+}
+
+func TestSourceGate_OnOff(t *testing.T) {
+	var (
+		src = rand.NewPCG(1, 2)
+		on  = sampling.NewSourceGate(sampling.On, src)
+		off = sampling.NewSourceGate(sampling.Off, src)
+	)
+
+	require.Equal(t, sampling.On, on.Gate())
+	require.Equal(t, sampling.Off, off.Gate())
+
+	for range 10_000 {
+		require.True(t, on.Try())
+		require.False(t, off.Try())
+	}
+}
+
+func TestSourceGate_TryKey(t *testing.T) {
+	g := sampling.NewPCGGate(0.25, 1, 2)
+	for _, key := range []string{"a", "b", "c", "abc", "trace-1"} {
+		require.Equal(t, sampling.Gate(0.25).TryKey(key), g.TryKey(key))
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package atomic_test
+
+import (
+	"sync/atomic"
+	"testing"
+
+	xatomic "go.mway.dev/x/sync/atomic"
+)
+
+func BenchmarkIntAdd(b *testing.B) {
+	b.Run("atomic", func(b *testing.B) {
+		var v atomic.Int64
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				v.Add(1)
+			}
+		})
+	})
+
+	b.Run("xatomic", func(b *testing.B) {
+		var v xatomic.Int[int64]
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				v.Add(1)
+			}
+		})
+	})
+}
+
+func BenchmarkIntLoad(b *testing.B) {
+	b.Run("atomic", func(b *testing.B) {
+		var v atomic.Int64
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_ = v.Load()
+			}
+		})
+	})
+
+	b.Run("xatomic", func(b *testing.B) {
+		var v xatomic.Int[int64]
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_ = v.Load()
+			}
+		})
+	})
+}
+
+func BenchmarkFloatAdd(b *testing.B) {
+	b.Run("atomic", func(b *testing.B) {
+		var v atomic.Value
+		v.Store(float64(0))
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				for {
+					cur := v.Load().(float64) //nolint:errcheck
+					if v.CompareAndSwap(cur, cur+1) {
+						break
+					}
+				}
+			}
+		})
+	})
+
+	b.Run("xatomic", func(b *testing.B) {
+		var v xatomic.Float[float64]
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				v.Add(1)
+			}
+		})
+	})
+}
+
+func BenchmarkBoolToggle(b *testing.B) {
+	b.Run("atomic", func(b *testing.B) {
+		var v atomic.Bool
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				for {
+					cur := v.Load()
+					if v.CompareAndSwap(cur, !cur) {
+						break
+					}
+				}
+			}
+		})
+	})
+
+	b.Run("xatomic", func(b *testing.B) {
+		var v xatomic.Bool
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				v.Toggle()
+			}
+		})
+	})
+}
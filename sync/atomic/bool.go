@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package atomic
+
+import (
+	"sync/atomic"
+)
+
+// Bool is an atomic boolean.
+type Bool struct {
+	_     noCopy
+	value atomic.Uint32
+}
+
+// NewBool creates a new Bool with the given initial value.
+func NewBool(initial bool) *Bool {
+	v := &Bool{}
+	v.Store(initial)
+	return v
+}
+
+// Load loads the currently held value.
+func (v *Bool) Load() bool {
+	return v.value.Load() != 0
+}
+
+// Store stores the given value.
+func (v *Bool) Store(val bool) {
+	v.value.Store(b32(val))
+}
+
+// Swap swaps the currently held value with the given value, returning the
+// previous value.
+func (v *Bool) Swap(val bool) bool {
+	return v.value.Swap(b32(val)) != 0
+}
+
+// CompareAndSwap performs an atomic compare and swap using oldval and newval.
+// The return value indicates whether a swap took place.
+func (v *Bool) CompareAndSwap(oldval bool, newval bool) bool {
+	return v.value.CompareAndSwap(b32(oldval), b32(newval))
+}
+
+// Toggle atomically negates the held value, returning the previous value.
+func (v *Bool) Toggle() bool {
+	for {
+		cur := v.value.Load()
+		if v.value.CompareAndSwap(cur, cur^1) {
+			return cur != 0
+		}
+	}
+}
+
+func b32(x bool) uint32 {
+	if x {
+		return 1
+	}
+	return 0
+}
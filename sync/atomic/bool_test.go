@@ -0,0 +1,67 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package atomic_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/sync/atomic"
+)
+
+func TestBool(t *testing.T) {
+	v := atomic.NewBool(true)
+	require.True(t, v.Load())
+	require.True(t, v.Toggle())
+	require.False(t, v.Load())
+	require.False(t, v.Toggle())
+	require.True(t, v.Load())
+	require.True(t, v.Swap(false))
+	require.False(t, v.Load())
+	require.False(t, v.CompareAndSwap(true, false))
+	require.True(t, v.CompareAndSwap(false, true))
+	require.True(t, v.Load())
+	v.Store(false)
+	require.False(t, v.Load())
+}
+
+func TestBool_ConcurrentToggle(t *testing.T) {
+	var (
+		v  atomic.Bool
+		wg sync.WaitGroup
+	)
+
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 101 {
+				v.Toggle()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 10 * 101 toggles is even.
+	require.False(t, v.Load())
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package atomic
+
+import (
+	"math"
+	"sync/atomic"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Float is a strongly-typed atomic floating point number.
+type Float[T constraints.Float] struct {
+	_     noCopy
+	value atomic.Uint64
+}
+
+// NewFloat creates a new Float with the given initial value.
+func NewFloat[T constraints.Float](initial T) *Float[T] {
+	v := &Float[T]{}
+	v.Store(initial)
+	return v
+}
+
+// Load loads the currently held value.
+func (v *Float[T]) Load() T {
+	return fromBits[T](v.value.Load())
+}
+
+// Store stores the given value.
+func (v *Float[T]) Store(val T) {
+	v.value.Store(toBits(val))
+}
+
+// Swap swaps the currently held value with the given value, returning the
+// previous value.
+func (v *Float[T]) Swap(val T) T {
+	return fromBits[T](v.value.Swap(toBits(val)))
+}
+
+// CompareAndSwap performs an atomic compare and swap using oldval and newval.
+// The return value indicates whether a swap took place. Values are compared
+// bitwise, so a NaN held value can be swapped by passing the same NaN as
+// oldval.
+func (v *Float[T]) CompareAndSwap(oldval T, newval T) bool {
+	return v.value.CompareAndSwap(toBits(oldval), toBits(newval))
+}
+
+// Add atomically adds delta to the held value, returning the new value.
+func (v *Float[T]) Add(delta T) T {
+	return v.Update(func(old T) T {
+		return old + delta
+	})
+}
+
+// Sub atomically subtracts delta from the held value, returning the new value.
+func (v *Float[T]) Sub(delta T) T {
+	return v.Update(func(old T) T {
+		return old - delta
+	})
+}
+
+// Min atomically stores the minimum of the held value and val, returning the
+// new value. If either value is NaN, the result is NaN.
+func (v *Float[T]) Min(val T) T {
+	return v.Update(func(old T) T {
+		return min(old, val)
+	})
+}
+
+// Max atomically stores the maximum of the held value and val, returning the
+// new value. If either value is NaN, the result is NaN.
+func (v *Float[T]) Max(val T) T {
+	return v.Update(func(old T) T {
+		return max(old, val)
+	})
+}
+
+// Update atomically replaces the held value with the result of calling fn
+// with it, returning the new value. fn may be called multiple times if the
+// held value is concurrently modified, and so should be free of side effects.
+func (v *Float[T]) Update(fn func(old T) T) T {
+	for {
+		var (
+			cur    = v.value.Load()
+			newval = fn(fromBits[T](cur))
+		)
+		if v.value.CompareAndSwap(cur, toBits(newval)) {
+			return newval
+		}
+	}
+}
+
+func toBits[T constraints.Float](x T) uint64 {
+	return math.Float64bits(float64(x))
+}
+
+func fromBits[T constraints.Float](x uint64) T {
+	return T(math.Float64frombits(x))
+}
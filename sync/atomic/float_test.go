@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package atomic_test
+
+import (
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/sync/atomic"
+)
+
+func TestFloat(t *testing.T) {
+	v := atomic.NewFloat(1.5)
+	require.InDelta(t, 1.5, v.Load(), 0)
+	require.InDelta(t, 4.0, v.Add(2.5), 0)
+	require.InDelta(t, 3.0, v.Sub(1), 0)
+	require.InDelta(t, 3.0, v.Swap(-2), 0)
+	require.InDelta(t, -2.0, v.Max(-5), 0)
+	require.InDelta(t, 0.5, v.Max(0.5), 0)
+	require.InDelta(t, 0.5, v.Min(1), 0)
+	require.InDelta(t, -1.0, v.Min(-1), 0)
+	require.False(t, v.CompareAndSwap(0, 1))
+	require.True(t, v.CompareAndSwap(-1, 1))
+	require.InDelta(t, 1.0, v.Load(), 0)
+	require.InDelta(t, 10.0, v.Update(func(old float64) float64 {
+		return old * 10
+	}), 0)
+
+	nan := v.Max(math.NaN())
+	require.True(t, math.IsNaN(nan))
+	require.True(t, math.IsNaN(v.Load()))
+	require.True(t, v.CompareAndSwap(nan, 0))
+	require.Zero(t, v.Load())
+}
+
+func TestFloat_Float32(t *testing.T) {
+	var v atomic.Float[float32]
+	require.Zero(t, v.Load())
+	require.InDelta(t, float32(0.25), v.Add(0.25), 0)
+	require.True(t, v.CompareAndSwap(0.25, 0.5))
+	require.InDelta(t, float32(0.5), v.Load(), 0)
+}
+
+func TestFloat_Concurrent(t *testing.T) {
+	const (
+		workers = 8
+		iters   = 1000
+	)
+
+	var (
+		v  atomic.Float[float64]
+		wg sync.WaitGroup
+	)
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range iters {
+				v.Add(0.5)
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.InDelta(t, workers*iters*0.5, v.Load(), 0)
+}
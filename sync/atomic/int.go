@@ -0,0 +1,122 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package atomic
+
+import (
+	"sync/atomic"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Int is a strongly-typed atomic integer. Values are stored in 64 bits, and
+// arithmetic wraps according to T.
+type Int[T constraints.Integer] struct {
+	_     noCopy
+	value atomic.Uint64
+}
+
+// NewInt creates a new Int with the given initial value.
+func NewInt[T constraints.Integer](initial T) *Int[T] {
+	v := &Int[T]{}
+	v.Store(initial)
+	return v
+}
+
+// Load loads the currently held value.
+func (v *Int[T]) Load() T {
+	return T(v.value.Load())
+}
+
+// Store stores the given value.
+func (v *Int[T]) Store(val T) {
+	v.value.Store(uint64(val))
+}
+
+// Swap swaps the currently held value with the given value, returning the
+// previous value.
+func (v *Int[T]) Swap(val T) T {
+	return T(v.value.Swap(uint64(val)))
+}
+
+// CompareAndSwap performs an atomic compare and swap using oldval and newval.
+// The return value indicates whether a swap took place.
+func (v *Int[T]) CompareAndSwap(oldval T, newval T) bool {
+	for {
+		cur := v.value.Load()
+		if T(cur) != oldval {
+			return false
+		}
+		if v.value.CompareAndSwap(cur, uint64(newval)) {
+			return true
+		}
+	}
+}
+
+// Add atomically adds delta to the held value, returning the new value.
+func (v *Int[T]) Add(delta T) T {
+	return T(v.value.Add(uint64(delta)))
+}
+
+// Sub atomically subtracts delta from the held value, returning the new value.
+func (v *Int[T]) Sub(delta T) T {
+	return T(v.value.Add(-uint64(delta)))
+}
+
+// Inc atomically increments the held value, returning the new value.
+func (v *Int[T]) Inc() T {
+	return v.Add(1)
+}
+
+// Dec atomically decrements the held value, returning the new value.
+func (v *Int[T]) Dec() T {
+	return v.Sub(1)
+}
+
+// Min atomically stores the minimum of the held value and val, returning the
+// new value.
+func (v *Int[T]) Min(val T) T {
+	return v.Update(func(old T) T {
+		return min(old, val)
+	})
+}
+
+// Max atomically stores the maximum of the held value and val, returning the
+// new value.
+func (v *Int[T]) Max(val T) T {
+	return v.Update(func(old T) T {
+		return max(old, val)
+	})
+}
+
+// Update atomically replaces the held value with the result of calling fn
+// with it, returning the new value. fn may be called multiple times if the
+// held value is concurrently modified, and so should be free of side effects.
+func (v *Int[T]) Update(fn func(old T) T) T {
+	for {
+		var (
+			cur    = v.value.Load()
+			newval = fn(T(cur))
+		)
+		if v.value.CompareAndSwap(cur, uint64(newval)) {
+			return newval
+		}
+	}
+}
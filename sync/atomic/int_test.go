@@ -0,0 +1,104 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package atomic_test
+
+import (
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/sync/atomic"
+)
+
+func TestInt(t *testing.T) {
+	v := atomic.NewInt(int64(10))
+	require.EqualValues(t, 10, v.Load())
+	require.EqualValues(t, 15, v.Add(5))
+	require.EqualValues(t, 12, v.Sub(3))
+	require.EqualValues(t, 13, v.Inc())
+	require.EqualValues(t, 12, v.Dec())
+	require.EqualValues(t, 12, v.Swap(-7))
+	require.EqualValues(t, -7, v.Load())
+	require.EqualValues(t, -7, v.Max(-10))
+	require.EqualValues(t, -3, v.Max(-3))
+	require.EqualValues(t, -3, v.Min(0))
+	require.EqualValues(t, -20, v.Min(-20))
+	require.False(t, v.CompareAndSwap(0, 1))
+	require.True(t, v.CompareAndSwap(-20, 1))
+	require.EqualValues(t, 1, v.Load())
+	require.EqualValues(t, 4, v.Update(func(old int64) int64 {
+		return old * 4
+	}))
+
+	v.Store(math.MinInt64)
+	require.EqualValues(t, math.MaxInt64, v.Dec())
+}
+
+func TestInt_Wrapping(t *testing.T) {
+	t.Run("signed", func(t *testing.T) {
+		var v atomic.Int[int8]
+		v.Store(math.MaxInt8)
+		require.EqualValues(t, math.MinInt8, v.Inc())
+		require.True(t, v.CompareAndSwap(math.MinInt8, -1))
+		require.EqualValues(t, -1, v.Load())
+		require.EqualValues(t, -1, v.Max(-2))
+		require.EqualValues(t, -2, v.Min(-2))
+		require.EqualValues(t, 3, v.Sub(-5))
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		var v atomic.Int[uint8]
+		require.EqualValues(t, math.MaxUint8, v.Dec())
+		require.True(t, v.CompareAndSwap(math.MaxUint8, 1))
+		require.EqualValues(t, 0, v.Sub(1))
+		require.EqualValues(t, 0, v.Min(3))
+		require.EqualValues(t, 3, v.Max(3))
+	})
+}
+
+func TestInt_Concurrent(t *testing.T) {
+	const (
+		workers = 8
+		iters   = 1000
+	)
+
+	var (
+		v  atomic.Int[int32]
+		hi atomic.Int[int32]
+		wg sync.WaitGroup
+	)
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range iters {
+				hi.Max(v.Inc())
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.EqualValues(t, workers*iters, v.Load())
+	require.EqualValues(t, workers*iters, hi.Load())
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package atomic
+
+import (
+	"sync/atomic"
+)
+
+// Pointer is an atomic pointer to a value of type T. It is otherwise identical
+// to the standard library's atomic.Pointer, with the addition of
+// [Pointer.Update].
+type Pointer[T any] struct {
+	_     noCopy
+	value atomic.Pointer[T]
+}
+
+// NewPointer creates a new Pointer with the given initial value.
+func NewPointer[T any](initial *T) *Pointer[T] {
+	v := &Pointer[T]{}
+	v.Store(initial)
+	return v
+}
+
+// Load loads the currently held pointer.
+func (v *Pointer[T]) Load() *T {
+	return v.value.Load()
+}
+
+// Store stores the given pointer.
+func (v *Pointer[T]) Store(val *T) {
+	v.value.Store(val)
+}
+
+// Swap swaps the currently held pointer with the given pointer, returning the
+// previous pointer.
+func (v *Pointer[T]) Swap(val *T) *T {
+	return v.value.Swap(val)
+}
+
+// CompareAndSwap performs an atomic compare and swap using oldval and newval.
+// The return value indicates whether a swap took place.
+func (v *Pointer[T]) CompareAndSwap(oldval *T, newval *T) bool {
+	return v.value.CompareAndSwap(oldval, newval)
+}
+
+// Update atomically replaces the held pointer with the result of calling fn
+// with it, returning the new pointer. fn may be called multiple times if the
+// held pointer is concurrently modified, and so should be free of side
+// effects.
+func (v *Pointer[T]) Update(fn func(old *T) *T) *T {
+	for {
+		var (
+			cur    = v.value.Load()
+			newval = fn(cur)
+		)
+		if v.value.CompareAndSwap(cur, newval) {
+			return newval
+		}
+	}
+}
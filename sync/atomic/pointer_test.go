@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package atomic_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/sync/atomic"
+)
+
+func TestPointer(t *testing.T) {
+	var (
+		a = &testStruct{"a"}
+		b = &testStruct{"b"}
+		v = atomic.NewPointer(a)
+	)
+
+	require.Same(t, a, v.Load())
+	require.Same(t, a, v.Swap(b))
+	require.Same(t, b, v.Load())
+	require.False(t, v.CompareAndSwap(a, b))
+	require.True(t, v.CompareAndSwap(b, a))
+	require.Same(t, a, v.Load())
+	v.Store(nil)
+	require.Nil(t, v.Load())
+	require.Same(t, b, v.Update(func(old *testStruct) *testStruct {
+		require.Nil(t, old)
+		return b
+	}))
+}
+
+func TestPointer_ConcurrentUpdate(t *testing.T) {
+	var (
+		v  = atomic.NewPointer(&testStruct{0})
+		wg sync.WaitGroup
+	)
+
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				v.Update(func(old *testStruct) *testStruct {
+					return &testStruct{old.val.(int) + 1} //nolint:errcheck
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 800, v.Load().val)
+}
@@ -60,3 +60,26 @@ func (v *Value[T]) Store(val T) {
 func (v *Value[T]) Swap(val T) T {
 	return v.value.Swap(val).(T) //nolint:errcheck
 }
+
+// Update atomically replaces the held T value with the result of calling fn
+// with it, returning the new T. fn may be called multiple times if the held
+// value is concurrently modified, and so should be free of side effects. As
+// with [Value.CompareAndSwap], Update panics if T is not comparable.
+func (v *Value[T]) Update(fn func(old T) T) T {
+	for {
+		var (
+			cur    = v.Load()
+			newval = fn(cur)
+		)
+		if v.CompareAndSwap(cur, newval) {
+			return newval
+		}
+	}
+}
+
+// noCopy may be embedded into structs which must not be copied after first
+// use; see https://golang.org/issues/8005#issuecomment-190753527.
+type noCopy struct{}
+
+func (*noCopy) Lock()   {}
+func (*noCopy) Unlock() {}
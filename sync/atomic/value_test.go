@@ -22,6 +22,7 @@ package atomic_test
 
 import (
 	"bytes"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -106,6 +107,31 @@ func TestValue_Swap(t *testing.T) {
 	}
 }
 
+func TestValue_Update(t *testing.T) {
+	var (
+		value = atomic.NewValue(0)
+		wg    sync.WaitGroup
+	)
+
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				value.Update(func(old int) int {
+					return old + 1
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 800, value.Load())
+	require.Equal(t, 1600, value.Update(func(old int) int {
+		return old * 2
+	}))
+}
+
 type testStruct struct {
 	val any
 }
@@ -0,0 +1,285 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrBroadcasterClosed is returned when publishing to a closed
+// [Broadcaster].
+var ErrBroadcasterClosed = errors.New("sync: broadcaster is closed")
+
+// A DropPolicy determines what a [Broadcaster] does when publishing to a
+// subscriber whose buffer is full.
+type DropPolicy int
+
+const (
+	// DropNewest drops the value being published.
+	DropNewest DropPolicy = iota
+	// DropOldest drops the oldest buffered value to make room for the value
+	// being published.
+	DropOldest
+	// Block blocks the publisher until the subscriber has room, the
+	// subscription is closed, or the publisher's context is done.
+	Block
+)
+
+// A Broadcaster fans values out to any number of subscribers. Each
+// [Subscription] has its own buffer and [DropPolicy], so a slow subscriber
+// only affects publishers if it uses [Block].
+//
+// A zero Broadcaster is ready for use.
+type Broadcaster[T any] struct {
+	subs      map[*Subscription[T]]struct{}
+	done      chan struct{}
+	initOnce  sync.Once
+	closeOnce sync.Once
+	mu        sync.RWMutex
+	closed    bool
+}
+
+// A Subscription receives values published to a [Broadcaster].
+type Subscription[T any] struct {
+	b        *Broadcaster[T]
+	ch       chan T
+	done     chan struct{}
+	dropped  atomic.Uint64
+	doneOnce sync.Once
+	mu       sync.RWMutex
+	policy   DropPolicy
+	closed   bool
+}
+
+// Subscribe creates a new [Subscription] that buffers up to size values and
+// applies policy once its buffer is full. If b is closed, the returned
+// subscription's channel is already closed.
+func (b *Broadcaster[T]) Subscribe(
+	size int,
+	policy DropPolicy,
+) *Subscription[T] {
+	sub := &Subscription[T]{
+		b:      b,
+		ch:     make(chan T, max(size, 0)),
+		done:   make(chan struct{}),
+		policy: policy,
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		sub.closeUnsafe()
+		return sub
+	}
+
+	if b.subs == nil {
+		b.subs = make(map[*Subscription[T]]struct{})
+	}
+	b.subs[sub] = struct{}{}
+	return sub
+}
+
+// Publish sends value to all current subscribers according to their drop
+// policies. Publish only blocks for subscribers using [Block], in which case
+// it returns ctx's error if ctx is done first. If b is closed, Publish returns
+// [ErrBroadcasterClosed].
+func (b *Broadcaster[T]) Publish(ctx context.Context, value T) error {
+	blocked, err := b.offer(value)
+	if err != nil {
+		return err
+	}
+
+	// n.b. Wait on full Block subscribers without holding the read lock, so
+	//      that they cannot block Subscribe or other subscriptions' Close.
+	for _, sub := range blocked {
+		if err := sub.sendContext(ctx, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// offer sends value to every subscriber without blocking, and returns the
+// subscribers using [Block] that did not have room for it.
+func (b *Broadcaster[T]) offer(value T) ([]*Subscription[T], error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.closed {
+		return nil, ErrBroadcasterClosed
+	}
+
+	var blocked []*Subscription[T]
+	for sub := range b.subs {
+		if !sub.trySend(value) {
+			blocked = append(blocked, sub)
+		}
+	}
+
+	return blocked, nil
+}
+
+// Len returns the number of current subscribers.
+func (b *Broadcaster[T]) Len() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subs)
+}
+
+// Close closes b and all of its subscriptions. Subsequent calls to
+// [Broadcaster.Publish] return [ErrBroadcasterClosed].
+func (b *Broadcaster[T]) Close() {
+	// n.b. Unblock any blocked publishers before acquiring the write lock.
+	b.closeOnce.Do(func() {
+		close(b.doneChan())
+	})
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for sub := range b.subs {
+		sub.closeUnsafe()
+	}
+	clear(b.subs)
+}
+
+func (b *Broadcaster[T]) doneChan() chan struct{} {
+	b.initOnce.Do(func() {
+		b.done = make(chan struct{})
+	})
+	return b.done
+}
+
+// C returns the channel on which published values are received. The channel
+// is closed when the subscription or its [Broadcaster] is closed.
+func (s *Subscription[T]) C() <-chan T {
+	return s.ch
+}
+
+// Dropped returns the number of values that were dropped because s's buffer
+// was full.
+func (s *Subscription[T]) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Close unsubscribes s from its [Broadcaster] and closes its channel.
+func (s *Subscription[T]) Close() {
+	// n.b. Unblock any publishers blocked on s before acquiring the write
+	//      lock.
+	s.stop()
+
+	s.b.mu.Lock()
+	defer s.b.mu.Unlock()
+
+	delete(s.b.subs, s)
+	s.closeUnsafe()
+}
+
+func (s *Subscription[T]) stop() {
+	s.doneOnce.Do(func() {
+		close(s.done)
+	})
+}
+
+// closeUnsafe closes s's channel. The broadcaster's write lock must be held so
+// that no publishers are offering values to s.
+func (s *Subscription[T]) closeUnsafe() {
+	s.stop()
+
+	// n.b. Wait for any publishers blocked on s, which were woken by stop.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.closed {
+		s.closed = true
+		close(s.ch)
+	}
+}
+
+// trySend sends value to s without blocking, applying s's policy if its buffer
+// is full. It returns false if s uses [Block] and has no room for value.
+func (s *Subscription[T]) trySend(value T) bool {
+	select {
+	case s.ch <- value:
+		return true
+	default:
+	}
+
+	switch s.policy {
+	case DropOldest:
+		s.sendDropOldest(value)
+	case Block:
+		return false
+	default:
+		s.dropped.Add(1)
+	}
+	return true
+}
+
+// sendContext sends value to s, blocking until s has room, s or its
+// broadcaster is closed, or ctx is done.
+func (s *Subscription[T]) sendContext(ctx context.Context, value T) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return nil
+	}
+
+	select {
+	case s.ch <- value:
+		return nil
+	case <-s.done:
+		return nil
+	case <-s.b.doneChan():
+		return ErrBroadcasterClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Subscription[T]) sendDropOldest(value T) {
+	// n.b. An unbuffered subscription has nothing to evict.
+	if cap(s.ch) == 0 {
+		s.dropped.Add(1)
+		return
+	}
+
+	for {
+		select {
+		case <-s.ch:
+			s.dropped.Add(1)
+		default:
+		}
+
+		select {
+		case s.ch <- value:
+			return
+		default:
+		}
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sync_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/sync"
+)
+
+func TestBroadcaster(t *testing.T) {
+	var (
+		b   sync.Broadcaster[int]
+		ctx = context.Background()
+		a   = b.Subscribe(4, sync.DropNewest)
+		c   = b.Subscribe(4, sync.DropNewest)
+	)
+
+	require.Equal(t, 2, b.Len())
+	require.NoError(t, b.Publish(ctx, 1))
+	require.NoError(t, b.Publish(ctx, 2))
+
+	for _, sub := range []*sync.Subscription[int]{a, c} {
+		require.Equal(t, 1, <-sub.C())
+		require.Equal(t, 2, <-sub.C())
+	}
+
+	a.Close()
+	a.Close()
+	require.Equal(t, 1, b.Len())
+	_, ok := <-a.C()
+	require.False(t, ok)
+
+	b.Close()
+	require.Zero(t, b.Len())
+	require.ErrorIs(t, b.Publish(ctx, 3), sync.ErrBroadcasterClosed)
+	_, ok = <-c.C()
+	require.False(t, ok)
+
+	// Subscribing to a closed broadcaster yields a closed subscription.
+	_, ok = <-b.Subscribe(1, sync.Block).C()
+	require.False(t, ok)
+	require.NotPanics(t, b.Close)
+}
+
+func TestBroadcaster_DropPolicies(t *testing.T) {
+	var (
+		b      sync.Broadcaster[int]
+		ctx    = context.Background()
+		newest = b.Subscribe(2, sync.DropNewest)
+		oldest = b.Subscribe(2, sync.DropOldest)
+	)
+
+	for i := range 5 {
+		require.NoError(t, b.Publish(ctx, i))
+	}
+
+	require.Equal(t, []int{0, 1}, drain(newest))
+	require.EqualValues(t, 3, newest.Dropped())
+	require.Equal(t, []int{3, 4}, drain(oldest))
+	require.EqualValues(t, 3, oldest.Dropped())
+}
+
+func TestBroadcaster_Block(t *testing.T) {
+	var (
+		b   sync.Broadcaster[int]
+		sub = b.Subscribe(1, sync.Block)
+	)
+
+	require.NoError(t, b.Publish(context.Background(), 1))
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		10*time.Millisecond,
+	)
+	defer cancel()
+	require.ErrorIs(t, b.Publish(ctx, 2), context.DeadlineExceeded)
+
+	published := make(chan error, 1)
+	go func() {
+		published <- b.Publish(context.Background(), 3)
+	}()
+
+	require.Equal(t, 1, <-sub.C())
+	require.NoError(t, <-published)
+	require.Equal(t, 3, <-sub.C())
+	require.Zero(t, sub.Dropped())
+
+	// Closing a subscription unblocks publishers blocked on it.
+	require.NoError(t, b.Publish(context.Background(), 4))
+	blocked := newBlockedContext()
+	go func() {
+		published <- b.Publish(blocked, 5)
+	}()
+	<-blocked.called
+	sub.Close()
+	require.NoError(t, <-published)
+
+	// Closing the broadcaster unblocks publishers blocked on subscribers.
+	sub = b.Subscribe(0, sync.Block)
+	blocked = newBlockedContext()
+	go func() {
+		published <- b.Publish(blocked, 6)
+	}()
+	<-blocked.called
+	b.Close()
+	require.ErrorIs(t, <-published, sync.ErrBroadcasterClosed)
+	_, ok := <-sub.C()
+	require.False(t, ok)
+}
+
+func TestBroadcaster_BlockDoesNotBlockOthers(t *testing.T) {
+	var (
+		b         sync.Broadcaster[int]
+		slow      = b.Subscribe(0, sync.Block)
+		other     = b.Subscribe(1, sync.DropNewest)
+		blocked   = newBlockedContext()
+		published = make(chan error, 1)
+	)
+
+	go func() {
+		published <- b.Publish(blocked, 1)
+	}()
+	<-blocked.called
+
+	// Subscribing and closing other subscriptions does not wait for the
+	// publisher blocked on slow.
+	sub := b.Subscribe(1, sync.DropNewest)
+	sub.Close()
+	require.Equal(t, 1, <-other.C())
+	other.Close()
+	require.Equal(t, 1, b.Len())
+
+	require.Equal(t, 1, <-slow.C())
+	require.NoError(t, <-published)
+}
+
+func drain[T any](sub *sync.Subscription[T]) []T {
+	var values []T
+	for {
+		select {
+		case v := <-sub.C():
+			values = append(values, v)
+		default:
+			return values
+		}
+	}
+}
+
+// A blockedContext signals when its Done channel is first requested, which
+// [sync.Broadcaster.Publish] only does once it blocks on a subscriber.
+type blockedContext struct {
+	context.Context
+	called chan struct{}
+}
+
+func newBlockedContext() blockedContext {
+	return blockedContext{
+		Context: context.Background(),
+		called:  make(chan struct{}, 1),
+	}
+}
+
+func (c blockedContext) Done() <-chan struct{} {
+	select {
+	case c.called <- struct{}{}:
+	default:
+	}
+	return c.Context.Done()
+}
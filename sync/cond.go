@@ -0,0 +1,110 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sync
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+// A Cond is a condition variable like [sync.Cond], except that waiting can be
+// canceled with a context.
+//
+// A Cond must be created with [NewCond].
+type Cond struct {
+	// L is held while observing or changing the condition.
+	L       sync.Locker
+	waiters list.List
+	mu      sync.Mutex
+}
+
+// NewCond creates a new [Cond] with the given [sync.Locker].
+func NewCond(l sync.Locker) *Cond {
+	return &Cond{
+		L: l,
+	}
+}
+
+// Wait atomically unlocks c.L and suspends the calling goroutine until it is
+// woken by [Cond.Signal] or [Cond.Broadcast]. c.L is locked again before Wait
+// returns.
+func (c *Cond) Wait() {
+	_ = c.WaitContext(context.Background())
+}
+
+// WaitContext atomically unlocks c.L and suspends the calling goroutine until
+// it is woken by [Cond.Signal] or [Cond.Broadcast], or until ctx is done. c.L
+// is locked again before WaitContext returns. If ctx is done before the
+// goroutine is woken, ctx's error is returned.
+func (c *Cond) WaitContext(ctx context.Context) error {
+	ready := make(chan struct{})
+
+	c.mu.Lock()
+	elem := c.waiters.PushBack(ready)
+	c.mu.Unlock()
+
+	c.L.Unlock()
+	defer c.L.Lock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	select {
+	case <-ready:
+		// n.b. The goroutine was woken concurrently with ctx being done;
+		//      consume the wakeup rather than losing it.
+		return nil
+	default:
+		c.waiters.Remove(elem)
+		return ctx.Err()
+	}
+}
+
+// Signal wakes one goroutine waiting on c, if there is any.
+func (c *Cond) Signal() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if front := c.waiters.Front(); front != nil {
+		c.wakeUnsafe(front)
+	}
+}
+
+// Broadcast wakes all goroutines waiting on c.
+func (c *Cond) Broadcast() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for c.waiters.Len() > 0 {
+		c.wakeUnsafe(c.waiters.Front())
+	}
+}
+
+func (c *Cond) wakeUnsafe(elem *list.Element) {
+	close(c.waiters.Remove(elem).(chan struct{})) //nolint:errcheck
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sync_test
+
+import (
+	"context"
+	stdsync "sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/sync"
+)
+
+func TestCond(t *testing.T) {
+	var (
+		mu    stdsync.Mutex
+		cond  = sync.NewCond(&mu)
+		ready bool
+		woken = make(chan struct{})
+	)
+
+	go func() {
+		defer close(woken)
+
+		mu.Lock()
+		defer mu.Unlock()
+		for !ready {
+			cond.Wait()
+		}
+	}()
+
+	mu.Lock()
+	ready = true
+	mu.Unlock()
+	cond.Broadcast()
+	<-woken
+}
+
+func TestCond_Signal(t *testing.T) {
+	var (
+		mu    stdsync.Mutex
+		cond  = sync.NewCond(&mu)
+		woken = make(chan int, 3)
+		wg    sync.WaitGroup
+	)
+
+	for i := range 3 {
+		wg.Inc()
+		go func() {
+			mu.Lock()
+			defer mu.Unlock()
+			wg.Done()
+			cond.Wait()
+			woken <- i
+		}()
+	}
+
+	// Each waiter holds mu until it waits, so once mu can be acquired after
+	// the WaitGroup drains, all of them are waiting.
+	wg.Wait()
+	mu.Lock()
+	mu.Unlock() //nolint:staticcheck
+
+	cond.Signal()
+	<-woken
+	select {
+	case <-woken:
+		require.FailNow(t, "signal woke more than one waiter")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	cond.Broadcast()
+	<-woken
+	<-woken
+}
+
+func TestCond_WaitContext(t *testing.T) {
+	var (
+		mu   stdsync.Mutex
+		cond = sync.NewCond(&mu)
+	)
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		10*time.Millisecond,
+	)
+	defer cancel()
+
+	mu.Lock()
+	require.ErrorIs(t, cond.WaitContext(ctx), context.DeadlineExceeded)
+	require.False(t, mu.TryLock(), "lock was not reacquired")
+	mu.Unlock()
+
+	// The canceled waiter no longer consumes signals.
+	woken := make(chan struct{})
+	go func() {
+		defer close(woken)
+		mu.Lock()
+		defer mu.Unlock()
+		cond.Wait()
+	}()
+
+	require.Eventually(t, func() bool {
+		cond.Signal()
+		select {
+		case <-woken:
+			return true
+		default:
+			return false
+		}
+	}, time.Second, time.Millisecond)
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+var errWouldBlock = errors.New("sync: lock would block")
+
+// A KeyedMutex is a set of mutual exclusion locks identified by keys of type
+// K. Locks are created on demand and reclaimed once they are no longer held
+// or waited on, so the set of keys need not be known in advance.
+//
+// A zero KeyedMutex is ready for use.
+type KeyedMutex[K comparable] struct {
+	rw KeyedRWMutex[K]
+}
+
+// Lock locks key, blocking until it is available.
+func (m *KeyedMutex[K]) Lock(key K) {
+	m.rw.Lock(key)
+}
+
+// LockContext locks key, blocking until it is available or ctx is done. If
+// ctx is done before key becomes available, key is not locked and ctx's error
+// is returned.
+func (m *KeyedMutex[K]) LockContext(ctx context.Context, key K) error {
+	return m.rw.LockContext(ctx, key)
+}
+
+// TryLock tries to lock key without blocking, and reports whether it
+// succeeded.
+func (m *KeyedMutex[K]) TryLock(key K) bool {
+	return m.rw.TryLock(key)
+}
+
+// Unlock unlocks key. It panics if key is not locked.
+func (m *KeyedMutex[K]) Unlock(key K) {
+	m.rw.Unlock(key)
+}
+
+// Len returns the number of keys that are currently locked or waited on.
+func (m *KeyedMutex[K]) Len() int {
+	return m.rw.Len()
+}
+
+// A KeyedRWMutex is a set of reader/writer mutual exclusion locks identified
+// by keys of type K. Locks are created on demand and reclaimed once they are
+// no longer held or waited on, so the set of keys need not be known in
+// advance. As with [sync.RWMutex], a blocked writer excludes new readers.
+//
+// A zero KeyedRWMutex is ready for use.
+type KeyedRWMutex[K comparable] struct {
+	entries map[K]*keyedEntry
+	mu      sync.Mutex
+}
+
+type keyedEntry struct {
+	cond           *Cond
+	refs           int
+	readers        int
+	waitingWriters int
+	writer         bool
+}
+
+// Lock locks key for writing, blocking until it is available.
+func (m *KeyedRWMutex[K]) Lock(key K) {
+	_ = m.LockContext(context.Background(), key)
+}
+
+// LockContext locks key for writing, blocking until it is available or ctx is
+// done. If ctx is done before key becomes available, key is not locked and
+// ctx's error is returned.
+func (m *KeyedRWMutex[K]) LockContext(ctx context.Context, key K) error {
+	return m.lock(ctx, key, true /* exclusive */, true /* block */)
+}
+
+// TryLock tries to lock key for writing without blocking, and reports whether
+// it succeeded.
+func (m *KeyedRWMutex[K]) TryLock(key K) bool {
+	return m.lock(context.Background(), key, true, false) == nil
+}
+
+// Unlock unlocks key for writing. It panics if key is not locked for writing.
+func (m *KeyedRWMutex[K]) Unlock(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.entries[key]
+	if !ok || !e.writer {
+		panic("sync: unlock of unlocked key")
+	}
+	e.writer = false
+	m.release(key, e)
+}
+
+// RLock locks key for reading, blocking until it is available.
+func (m *KeyedRWMutex[K]) RLock(key K) {
+	_ = m.RLockContext(context.Background(), key)
+}
+
+// RLockContext locks key for reading, blocking until it is available or ctx is
+// done. If ctx is done before key becomes available, key is not locked and
+// ctx's error is returned.
+func (m *KeyedRWMutex[K]) RLockContext(ctx context.Context, key K) error {
+	return m.lock(ctx, key, false /* exclusive */, true /* block */)
+}
+
+// TryRLock tries to lock key for reading without blocking, and reports
+// whether it succeeded.
+func (m *KeyedRWMutex[K]) TryRLock(key K) bool {
+	return m.lock(context.Background(), key, false, false) == nil
+}
+
+// RUnlock undoes a single [KeyedRWMutex.RLock] call for key. It panics if key
+// is not locked for reading.
+func (m *KeyedRWMutex[K]) RUnlock(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.entries[key]
+	if !ok || e.readers == 0 {
+		panic("sync: runlock of unlocked key")
+	}
+	e.readers--
+	m.release(key, e)
+}
+
+// Len returns the number of keys that are currently locked or waited on.
+func (m *KeyedRWMutex[K]) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.entries)
+}
+
+func (m *KeyedRWMutex[K]) lock(
+	ctx context.Context,
+	key K,
+	exclusive bool,
+	block bool,
+) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.entries == nil {
+		m.entries = make(map[K]*keyedEntry)
+	}
+
+	e, ok := m.entries[key]
+	if !ok {
+		e = &keyedEntry{}
+		m.entries[key] = e
+	}
+	e.refs++
+
+	if exclusive {
+		e.waitingWriters++
+	}
+
+	for !e.tryAcquire(exclusive) {
+		err := errWouldBlock
+		if block {
+			if err = ctx.Err(); err == nil {
+				err = m.wait(ctx, e)
+			}
+		}
+
+		if err != nil {
+			// n.b. A waiting writer may have been excluding readers, so
+			//      release wakes any waiters to re-evaluate.
+			if exclusive {
+				e.waitingWriters--
+			}
+			m.release(key, e)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// wait waits for e to be released or for ctx to be done. m.mu must be held,
+// and is held again when wait returns.
+func (m *KeyedRWMutex[K]) wait(ctx context.Context, e *keyedEntry) error {
+	if e.cond == nil {
+		e.cond = NewCond(&m.mu)
+	}
+	return e.cond.WaitContext(ctx)
+}
+
+// tryAcquire acquires e if it is available, and reports whether it did.
+func (e *keyedEntry) tryAcquire(exclusive bool) bool {
+	switch {
+	case exclusive && !e.writer && e.readers == 0:
+		e.waitingWriters--
+		e.writer = true
+		return true
+	case !exclusive && !e.writer && e.waitingWriters == 0:
+		e.readers++
+		return true
+	default:
+		return false
+	}
+}
+
+// release drops a reference to e, waking any waiters and reclaiming e if it
+// is no longer referenced. m.mu must be held.
+func (m *KeyedRWMutex[K]) release(key K, e *keyedEntry) {
+	e.refs--
+	if e.cond != nil {
+		e.cond.Broadcast()
+	}
+	if e.refs == 0 {
+		delete(m.entries, key)
+	}
+}
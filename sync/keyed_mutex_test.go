@@ -0,0 +1,209 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sync_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/sync"
+)
+
+func TestKeyedMutex(t *testing.T) {
+	var m sync.KeyedMutex[string]
+
+	m.Lock("a")
+	require.Equal(t, 1, m.Len())
+	require.False(t, m.TryLock("a"))
+	require.True(t, m.TryLock("b"))
+	require.Equal(t, 2, m.Len())
+
+	m.Unlock("b")
+	require.Equal(t, 1, m.Len())
+
+	locked := make(chan struct{})
+	go func() {
+		defer close(locked)
+		m.Lock("a")
+	}()
+
+	select {
+	case <-locked:
+		require.FailNow(t, "acquired held lock")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	m.Unlock("a")
+	<-locked
+	require.Equal(t, 1, m.Len())
+
+	m.Unlock("a")
+	require.Zero(t, m.Len())
+}
+
+func TestKeyedMutex_LockContext(t *testing.T) {
+	var m sync.KeyedMutex[int]
+
+	require.NoError(t, m.LockContext(context.Background(), 1))
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		10*time.Millisecond,
+	)
+	defer cancel()
+
+	err := m.LockContext(ctx, 1)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, 1, m.Len())
+
+	m.Unlock(1)
+	require.Zero(t, m.Len())
+
+	// An available lock is acquired even if ctx is already done.
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.NoError(t, m.LockContext(canceled, 1))
+	require.ErrorIs(t, m.LockContext(canceled, 1), context.Canceled)
+	m.Unlock(1)
+	require.Zero(t, m.Len())
+}
+
+func TestKeyedMutex_UnlockUnlocked(t *testing.T) {
+	var m sync.KeyedMutex[int]
+
+	require.Panics(t, func() { m.Unlock(1) })
+}
+
+func TestKeyedMutex_Exclusive(t *testing.T) {
+	var (
+		m       sync.KeyedMutex[int]
+		wg      sync.WaitGroup
+		counts  = make([]int, 4)
+		workers = 8
+		iters   = 1000
+	)
+
+	for i := range workers {
+		wg.Go(func() error {
+			for j := range iters {
+				key := (i + j) % len(counts)
+				m.Lock(key)
+				counts[key]++
+				m.Unlock(key)
+			}
+			return nil
+		})
+	}
+
+	wg.Wait()
+	require.NoError(t, wg.Err())
+
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+	require.Equal(t, workers*iters, total)
+	require.Zero(t, m.Len())
+}
+
+func TestKeyedRWMutex(t *testing.T) {
+	var m sync.KeyedRWMutex[string]
+
+	m.RLock("a")
+	require.True(t, m.TryRLock("a"))
+	require.False(t, m.TryLock("a"))
+	require.Equal(t, 1, m.Len())
+
+	m.RUnlock("a")
+	m.RUnlock("a")
+	require.Zero(t, m.Len())
+
+	m.Lock("a")
+	require.False(t, m.TryRLock("a"))
+	require.False(t, m.TryLock("a"))
+	m.Unlock("a")
+	require.Zero(t, m.Len())
+
+	require.Panics(t, func() { m.RUnlock("a") })
+	require.Panics(t, func() { m.Unlock("a") })
+}
+
+func TestKeyedRWMutex_WriterExcludesNewReaders(t *testing.T) {
+	var m sync.KeyedRWMutex[int]
+
+	m.RLock(1)
+
+	locked := make(chan struct{})
+	go func() {
+		defer close(locked)
+		m.Lock(1)
+	}()
+
+	// Wait until the writer is queued behind the reader.
+	require.Eventually(t, func() bool {
+		return !m.TryRLock(1)
+	}, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		10*time.Millisecond,
+	)
+	defer cancel()
+	require.ErrorIs(t, m.RLockContext(ctx, 1), context.DeadlineExceeded)
+
+	m.RUnlock(1)
+	<-locked
+	m.Unlock(1)
+	require.Zero(t, m.Len())
+}
+
+func TestKeyedRWMutex_CanceledWriterReleasesReaders(t *testing.T) {
+	var m sync.KeyedRWMutex[int]
+
+	m.RLock(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	go func() {
+		errs <- m.LockContext(ctx, 1)
+	}()
+
+	require.Eventually(t, func() bool {
+		return !m.TryRLock(1)
+	}, time.Second, time.Millisecond)
+
+	rlocked := make(chan struct{})
+	go func() {
+		defer close(rlocked)
+		m.RLock(1)
+	}()
+
+	cancel()
+	require.ErrorIs(t, <-errs, context.Canceled)
+	<-rlocked
+
+	m.RUnlock(1)
+	m.RUnlock(1)
+	require.Zero(t, m.Len())
+}
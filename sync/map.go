@@ -0,0 +1,274 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sync
+
+import (
+	"hash/maphash"
+	"iter"
+	"runtime"
+	"sync"
+	"unsafe"
+
+	xmath "go.mway.dev/x/math"
+)
+
+const (
+	_cacheLineSize = 64
+	// _shardPad pads each map shard to a cache line to avoid false sharing.
+	_shardPad = _cacheLineSize - (unsafe.Sizeof(map[int]int(nil))+
+		unsafe.Sizeof(sync.RWMutex{}))%_cacheLineSize
+)
+
+// _defaultShards is the default number of shards for a zero [Map].
+var _defaultShards = xmath.NextPow2(runtime.GOMAXPROCS(0) * 4)
+
+// A Map is a map that is safe for concurrent use. Keys are distributed across
+// a number of independently-locked shards to reduce contention.
+//
+// A zero Map is empty and ready for use.
+type Map[K comparable, V any] struct {
+	shards []mapShard[K, V]
+	seed   maphash.Seed
+	mask   uint64
+	once   sync.Once
+}
+
+type mapShard[K comparable, V any] struct {
+	m  map[K]V
+	mu sync.RWMutex
+	_  [_shardPad]byte
+}
+
+// NewMap creates a new [Map] with the given number of shards, which is
+// rounded up to a power of two. If shards is less than 1, a default based on
+// GOMAXPROCS is used.
+func NewMap[K comparable, V any](shards int) *Map[K, V] {
+	m := &Map[K, V]{}
+	m.once.Do(func() {
+		m.init(shards)
+	})
+	return m
+}
+
+// Load returns the value stored for key, if any. The boolean indicates
+// whether a value was found.
+func (m *Map[K, V]) Load(key K) (V, bool) {
+	s := m.shard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	value, ok := s.m[key]
+	return value, ok
+}
+
+// Store stores value for key.
+func (m *Map[K, V]) Store(key K, value V) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m[key] = value
+}
+
+// LoadOrStore returns the existing value for key if present. Otherwise, it
+// stores and returns the given value. The boolean is true if the value was
+// loaded and false if it was stored.
+func (m *Map[K, V]) LoadOrStore(key K, value V) (V, bool) {
+	s := m.shard(key)
+
+	s.mu.RLock()
+	actual, ok := s.m[key]
+	s.mu.RUnlock()
+	if ok {
+		return actual, true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if actual, ok = s.m[key]; ok {
+		return actual, true
+	}
+	s.m[key] = value
+	return value, false
+}
+
+// LoadAndDelete deletes the value for key, returning the previous value if
+// any. The boolean indicates whether a value was present.
+func (m *Map[K, V]) LoadAndDelete(key K) (V, bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.m[key]
+	if ok {
+		delete(s.m, key)
+	}
+	return value, ok
+}
+
+// Delete deletes the value for key.
+func (m *Map[K, V]) Delete(key K) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.m, key)
+}
+
+// Swap stores value for key and returns the previous value, if any. The
+// boolean indicates whether a previous value was present.
+func (m *Map[K, V]) Swap(key K, value V) (V, bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	prev, ok := s.m[key]
+	s.m[key] = value
+	return prev, ok
+}
+
+// Compute atomically updates the value for key by calling fn with the current
+// value (if any) and a boolean indicating whether it is present. If fn returns
+// true, the value it returns is stored for key; otherwise, key is deleted.
+// Compute returns the resulting value and whether it is present. fn must not
+// call other methods on m.
+func (m *Map[K, V]) Compute(
+	key K,
+	fn func(value V, ok bool) (V, bool),
+) (V, bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.m[key]
+	if value, ok = fn(value, ok); ok {
+		s.m[key] = value
+	} else {
+		delete(s.m, key)
+	}
+	return value, ok
+}
+
+// All returns an iterator over the keys and values in m. Each shard is
+// copied before its entries are yielded, so yield may safely call other
+// methods on m; however, the iteration does not reflect a consistent snapshot
+// of the entire map if it is concurrently modified.
+func (m *Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.once.Do(func() {
+			m.init(0)
+		})
+
+		var (
+			keys   []K
+			values []V
+		)
+		for i := range m.shards {
+			keys, values = m.shards[i].copyInto(keys[:0], values[:0])
+			for j := range keys {
+				if !yield(keys[j], values[j]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the keys in m. It is subject to the same
+// consistency caveats as [Map.All].
+func (m *Map[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values in m. It is subject to the same
+// consistency caveats as [Map.All].
+func (m *Map[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of entries in m. If m is concurrently modified, the
+// result may not reflect the map's state at any single point in time.
+func (m *Map[K, V]) Len() int {
+	m.once.Do(func() {
+		m.init(0)
+	})
+
+	var n int
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.RLock()
+		n += len(s.m)
+		s.mu.RUnlock()
+	}
+	return n
+}
+
+// Clear deletes all entries in m.
+func (m *Map[K, V]) Clear() {
+	m.once.Do(func() {
+		m.init(0)
+	})
+
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.Lock()
+		clear(s.m)
+		s.mu.Unlock()
+	}
+}
+
+func (m *Map[K, V]) init(shards int) {
+	if shards < 1 {
+		shards = _defaultShards
+	}
+	shards = xmath.NextPow2(shards)
+
+	m.seed = maphash.MakeSeed()
+	m.mask = uint64(shards - 1)
+	m.shards = make([]mapShard[K, V], shards)
+	for i := range m.shards {
+		m.shards[i].m = make(map[K]V)
+	}
+}
+
+func (m *Map[K, V]) shard(key K) *mapShard[K, V] {
+	m.once.Do(func() {
+		m.init(0)
+	})
+	return &m.shards[maphash.Comparable(m.seed, key)&m.mask]
+}
+
+func (s *mapShard[K, V]) copyInto(keys []K, values []V) ([]K, []V) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for k, v := range s.m {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	return keys, values
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sync_test
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	xsync "go.mway.dev/x/sync"
+)
+
+const _benchmarkMapKeys = 1024
+
+var _benchmarkMapKeyNames = func() []string {
+	keys := make([]string, _benchmarkMapKeys)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+	}
+	return keys
+}()
+
+type benchmarkMap interface {
+	Load(key string) (int, bool)
+	Store(key string, value int)
+}
+
+type syncMap struct {
+	m sync.Map
+}
+
+func (m *syncMap) Load(key string) (int, bool) {
+	v, ok := m.m.Load(key)
+	if !ok {
+		return 0, false
+	}
+	return v.(int), true //nolint:errcheck
+}
+
+func (m *syncMap) Store(key string, value int) {
+	m.m.Store(key, value)
+}
+
+type mutexMap struct {
+	m  map[string]int
+	mu sync.RWMutex
+}
+
+func (m *mutexMap) Load(key string) (int, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	v, ok := m.m[key]
+	return v, ok
+}
+
+func (m *mutexMap) Store(key string, value int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.m[key] = value
+}
+
+func BenchmarkMap(b *testing.B) {
+	impls := []struct {
+		newMap func() benchmarkMap
+		name   string
+	}{
+		{
+			name:   "xsync.Map",
+			newMap: func() benchmarkMap { return &xsync.Map[string, int]{} },
+		},
+		{
+			name:   "sync.Map",
+			newMap: func() benchmarkMap { return &syncMap{} },
+		},
+		{
+			name: "mutex",
+			newMap: func() benchmarkMap {
+				return &mutexMap{m: make(map[string]int)}
+			},
+		},
+	}
+
+	loads := []int{50, 90, 99}
+
+	for _, impl := range impls {
+		for _, pct := range loads {
+			name := impl.name + "/loads=" + strconv.Itoa(pct) + "%"
+			b.Run(name, func(b *testing.B) {
+				m := impl.newMap()
+				for i, key := range _benchmarkMapKeyNames {
+					m.Store(key, i)
+				}
+
+				b.ReportAllocs()
+				b.ResetTimer()
+
+				b.RunParallel(func(pb *testing.PB) {
+					var i int
+					for pb.Next() {
+						key := _benchmarkMapKeyNames[i%_benchmarkMapKeys]
+						if i%100 < pct {
+							m.Load(key)
+						} else {
+							m.Store(key, i)
+						}
+						i++
+					}
+				})
+			})
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sync_test
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/sync"
+)
+
+func TestMap(t *testing.T) {
+	var m sync.Map[string, int]
+
+	_, ok := m.Load("a")
+	require.False(t, ok)
+	require.Zero(t, m.Len())
+
+	m.Store("a", 1)
+	have, ok := m.Load("a")
+	require.True(t, ok)
+	require.Equal(t, 1, have)
+
+	have, loaded := m.LoadOrStore("a", 2)
+	require.True(t, loaded)
+	require.Equal(t, 1, have)
+	have, loaded = m.LoadOrStore("b", 2)
+	require.False(t, loaded)
+	require.Equal(t, 2, have)
+
+	prev, ok := m.Swap("a", 10)
+	require.True(t, ok)
+	require.Equal(t, 1, prev)
+	prev, ok = m.Swap("c", 3)
+	require.False(t, ok)
+	require.Zero(t, prev)
+	require.Equal(t, 3, m.Len())
+
+	require.Equal(
+		t,
+		map[string]int{"a": 10, "b": 2, "c": 3},
+		maps.Collect(m.All()),
+	)
+
+	have, ok = m.LoadAndDelete("c")
+	require.True(t, ok)
+	require.Equal(t, 3, have)
+	_, ok = m.LoadAndDelete("c")
+	require.False(t, ok)
+
+	m.Delete("b")
+	require.Equal(t, []string{"a"}, slices.Collect(m.Keys()))
+	require.Equal(t, []int{10}, slices.Collect(m.Values()))
+
+	m.Clear()
+	require.Zero(t, m.Len())
+}
+
+func TestMap_Compute(t *testing.T) {
+	m := sync.NewMap[string, int](3)
+
+	incr := func(value int, ok bool) (int, bool) {
+		if !ok {
+			return 1, true
+		}
+		return value + 1, true
+	}
+
+	for i := range 3 {
+		have, ok := m.Compute("a", incr)
+		require.True(t, ok)
+		require.Equal(t, i+1, have)
+	}
+
+	have, ok := m.Compute("a", func(value int, ok bool) (int, bool) {
+		require.True(t, ok)
+		require.Equal(t, 3, value)
+		return 0, false
+	})
+	require.False(t, ok)
+	require.Zero(t, have)
+	require.Zero(t, m.Len())
+}
+
+func TestMap_AllEarlyExit(t *testing.T) {
+	m := sync.NewMap[int, int](0)
+	for i := range 100 {
+		m.Store(i, i)
+	}
+
+	var n int
+	for k, v := range m.All() {
+		require.Equal(t, k, v)
+		// Modifying the map while iterating does not deadlock.
+		m.Store(k, v)
+		if n++; n == 10 {
+			break
+		}
+	}
+	require.Equal(t, 10, n)
+
+	for range m.Keys() {
+		break
+	}
+	for range m.Values() {
+		break
+	}
+}
+
+func TestMap_Concurrent(t *testing.T) {
+	const (
+		workers = 8
+		keys    = 100
+	)
+
+	var (
+		m  sync.Map[string, int]
+		wg sync.WaitGroup
+	)
+
+	for w := range workers {
+		wg.Go(func() error {
+			for i := range keys {
+				key := strconv.Itoa(i)
+				m.Compute(key, func(value int, _ bool) (int, bool) {
+					return value + 1, true
+				})
+				m.LoadOrStore(strconv.Itoa(w*keys+i+keys), i)
+				m.Load(key)
+			}
+			return nil
+		})
+	}
+	require.NoError(t, wg.WaitContext(t.Context()))
+
+	for i := range keys {
+		have, ok := m.Load(strconv.Itoa(i))
+		require.True(t, ok)
+		require.Equal(t, workers, have)
+	}
+	require.Equal(t, keys+workers*keys, m.Len())
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sync
+
+import (
+	"context"
+	"sync"
+)
+
+// A Notifier is a coalescing signal: any number of calls to
+// [Notifier.Notify] made while no receiver is waiting result in at most one
+// pending notification.
+//
+// A zero Notifier is ready for use.
+type Notifier struct {
+	ch   chan struct{}
+	once sync.Once
+}
+
+// NewNotifier creates a new [Notifier].
+func NewNotifier() *Notifier {
+	n := &Notifier{}
+	n.init()
+	return n
+}
+
+// Notify sends a notification without blocking. If a notification is already
+// pending, Notify does nothing.
+func (n *Notifier) Notify() {
+	select {
+	case n.signal() <- struct{}{}:
+	default:
+	}
+}
+
+// C returns a channel that receives a value whenever a notification is
+// pending.
+func (n *Notifier) C() <-chan struct{} {
+	return n.signal()
+}
+
+// Wait blocks until a notification is received or ctx is done. If ctx is
+// done first, ctx's error is returned.
+func (n *Notifier) Wait(ctx context.Context) error {
+	select {
+	case <-n.C():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (n *Notifier) signal() chan struct{} {
+	n.init()
+	return n.ch
+}
+
+func (n *Notifier) init() {
+	n.once.Do(func() {
+		n.ch = make(chan struct{}, 1)
+	})
+}
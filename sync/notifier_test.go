@@ -0,0 +1,72 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sync_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/sync"
+)
+
+func TestNotifier(t *testing.T) {
+	for name, n := range map[string]*sync.Notifier{
+		"zero": new(sync.Notifier),
+		"new":  sync.NewNotifier(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			select {
+			case <-n.C():
+				require.FailNow(t, "unexpected notification")
+			default:
+			}
+
+			// Multiple notifications coalesce into one.
+			n.Notify()
+			n.Notify()
+			n.Notify()
+
+			<-n.C()
+			select {
+			case <-n.C():
+				require.FailNow(t, "notifications did not coalesce")
+			default:
+			}
+		})
+	}
+}
+
+func TestNotifier_Wait(t *testing.T) {
+	var n sync.Notifier
+
+	go n.Notify()
+	require.NoError(t, n.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		10*time.Millisecond,
+	)
+	defer cancel()
+	require.ErrorIs(t, n.Wait(ctx), context.DeadlineExceeded)
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.mway.dev/x/container/queue"
+)
+
+// ErrPoolClosed is returned when submitting a task to a closed [Pool].
+var ErrPoolClosed = errors.New("sync: pool is closed")
+
+// A Pool runs tasks on a bounded number of goroutines. Tasks that cannot be
+// started immediately are held in a bounded queue; once the queue is full,
+// submitters block until space is available, applying backpressure.
+//
+// Pool must be created with [NewPool].
+type Pool struct {
+	sem     *Semaphore
+	queue   *queue.Queue[func()]
+	space   *Cond
+	wg      WaitGroup
+	size    int
+	running int
+	mu      sync.Mutex
+	closed  bool
+}
+
+// NewPool creates a new [Pool] that runs at most workers tasks concurrently
+// and queues at most queueSize further tasks. A queueSize of zero disables
+// queueing, such that submitters block until a worker is available.
+func NewPool(workers int, queueSize int) *Pool {
+	if workers < 1 {
+		panic("sync: pool requires at least one worker")
+	}
+
+	queueSize = max(queueSize, 0)
+	p := &Pool{
+		sem:   NewSemaphore(int64(workers)),
+		queue: queue.New[func()](queueSize),
+		size:  queueSize,
+	}
+	p.space = NewCond(&p.mu)
+	return p
+}
+
+// Submit submits fn to be run by the pool, blocking until fn is started or
+// queued, ctx is done, or the pool is closed. If fn is not accepted, Submit
+// returns ctx's error or [ErrPoolClosed], respectively.
+func (p *Pool) Submit(ctx context.Context, fn func()) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for {
+		accepted, err := p.trySubmitUnsafe(fn)
+		if accepted || err != nil {
+			return err
+		}
+
+		if err = p.space.WaitContext(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// TrySubmit submits fn to be run by the pool without blocking, and reports
+// whether fn was started or queued.
+func (p *Pool) TrySubmit(fn func()) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	accepted, _ := p.trySubmitUnsafe(fn)
+	return accepted
+}
+
+// Execute runs fn on the pool, blocking until it is started or queued. If
+// the pool is closed, fn is dropped.
+func (p *Pool) Execute(fn func()) {
+	_ = p.Submit(context.Background(), fn)
+}
+
+// InFlight returns the number of tasks that are currently running.
+func (p *Pool) InFlight() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.running
+}
+
+// Queued returns the number of tasks that are waiting to be run.
+func (p *Pool) Queued() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.queue.Len()
+}
+
+// Close stops the pool from accepting new tasks. Tasks that have already been
+// accepted continue to run; use [Pool.Wait] to wait for them to finish.
+func (p *Pool) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed = true
+	p.space.Broadcast()
+}
+
+// Wait blocks until all accepted tasks have finished.
+func (p *Pool) Wait() {
+	p.wg.Wait()
+}
+
+// WaitContext blocks until all accepted tasks have finished or ctx is done.
+// If ctx is done first, ctx's error is returned.
+func (p *Pool) WaitContext(ctx context.Context) error {
+	return p.wg.WaitContext(ctx)
+}
+
+func (p *Pool) trySubmitUnsafe(fn func()) (bool, error) {
+	if p.closed {
+		return false, ErrPoolClosed
+	}
+
+	if p.sem.TryAcquire(1) {
+		p.running++
+		p.wg.Inc()
+		go p.work(fn)
+		return true, nil
+	}
+
+	if p.queue.Len() < p.size {
+		p.queue.Push(fn)
+		p.wg.Inc()
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (p *Pool) work(fn func()) {
+	for {
+		fn()
+		p.wg.Done()
+
+		p.mu.Lock()
+		next, ok := p.queue.MaybePop()
+		if !ok {
+			p.running--
+			p.sem.Release(1)
+		}
+		p.space.Signal()
+		p.mu.Unlock()
+
+		if !ok {
+			return
+		}
+		fn = next
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sync_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/sync"
+	"go.mway.dev/x/sync/atomic"
+)
+
+func TestPool(t *testing.T) {
+	var (
+		pool    = sync.NewPool(4, 16)
+		n       atomic.Int[int64]
+		running atomic.Int[int64]
+		peak    atomic.Int[int64]
+	)
+
+	for range 100 {
+		require.NoError(t, pool.Submit(context.Background(), func() {
+			peak.Max(running.Inc())
+			defer running.Dec()
+			time.Sleep(time.Millisecond)
+			n.Inc()
+		}))
+	}
+
+	pool.Wait()
+	require.EqualValues(t, 100, n.Load())
+	require.LessOrEqual(t, peak.Load(), int64(4))
+	require.Zero(t, pool.InFlight())
+	require.Zero(t, pool.Queued())
+}
+
+func TestPool_Backpressure(t *testing.T) {
+	var (
+		pool    = sync.NewPool(1, 1)
+		release = make(chan struct{})
+		block   = func() { <-release }
+	)
+
+	require.True(t, pool.TrySubmit(block))
+	require.Eventually(t, func() bool {
+		return pool.InFlight() == 1
+	}, time.Second, time.Millisecond)
+
+	require.True(t, pool.TrySubmit(block))
+	require.Equal(t, 1, pool.Queued())
+	require.False(t, pool.TrySubmit(block))
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		10*time.Millisecond,
+	)
+	defer cancel()
+	require.ErrorIs(
+		t,
+		pool.Submit(ctx, block),
+		context.DeadlineExceeded,
+	)
+
+	submitted := make(chan error, 1)
+	go func() {
+		submitted <- pool.Submit(context.Background(), block)
+	}()
+
+	release <- struct{}{}
+	require.NoError(t, <-submitted)
+	require.Equal(t, 1, pool.InFlight())
+	require.Equal(t, 1, pool.Queued())
+
+	close(release)
+	pool.Wait()
+	require.Zero(t, pool.InFlight())
+	require.Zero(t, pool.Queued())
+}
+
+func TestPool_NoQueue(t *testing.T) {
+	var (
+		pool    = sync.NewPool(1, 0)
+		release = make(chan struct{})
+	)
+
+	require.True(t, pool.TrySubmit(func() { <-release }))
+	require.False(t, pool.TrySubmit(func() {}))
+
+	close(release)
+	require.NoError(t, pool.Submit(context.Background(), func() {}))
+	require.NoError(t, pool.WaitContext(context.Background()))
+}
+
+func TestPool_Close(t *testing.T) {
+	var (
+		pool    = sync.NewPool(1, 0)
+		release = make(chan struct{})
+		ran     atomic.Bool
+	)
+
+	require.True(t, pool.TrySubmit(func() {
+		<-release
+		ran.Store(true)
+	}))
+
+	submitted := make(chan error, 1)
+	go func() {
+		submitted <- pool.Submit(context.Background(), func() {})
+	}()
+
+	pool.Close()
+	require.ErrorIs(t, <-submitted, sync.ErrPoolClosed)
+	require.False(t, pool.TrySubmit(func() {}))
+
+	close(release)
+	pool.Wait()
+	require.True(t, ran.Load())
+}
+
+func TestNewPool_InvalidWorkers(t *testing.T) {
+	require.Panics(t, func() { sync.NewPool(0, 1) })
+}
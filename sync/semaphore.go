@@ -0,0 +1,142 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sync
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+// A Semaphore is a weighted counting semaphore. Waiters are served in FIFO
+// order, so a large request is not starved by a stream of smaller ones.
+type Semaphore struct {
+	waiters list.List
+	size    int64
+	cur     int64
+	mu      sync.Mutex
+}
+
+type semaphoreWaiter struct {
+	ready chan struct{}
+	n     int64
+}
+
+// NewSemaphore creates a new [Semaphore] with the given maximum combined
+// weight for concurrent access.
+func NewSemaphore(n int64) *Semaphore {
+	return &Semaphore{
+		size: n,
+	}
+}
+
+// Acquire acquires the semaphore with a weight of n, blocking until resources
+// are available or ctx is done. On success, it returns nil; on failure, it
+// returns ctx's error and leaves the semaphore unchanged.
+//
+// If n exceeds the size of the semaphore, Acquire blocks until ctx is done.
+func (s *Semaphore) Acquire(ctx context.Context, n int64) error {
+	s.mu.Lock()
+	if s.size-s.cur >= n && s.waiters.Len() == 0 {
+		s.cur += n
+		s.mu.Unlock()
+		return nil
+	}
+
+	if n > s.size {
+		s.mu.Unlock()
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	ready := make(chan struct{})
+	elem := s.waiters.PushBack(semaphoreWaiter{
+		ready: ready,
+		n:     n,
+	})
+	s.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	select {
+	case <-ready:
+		// The semaphore was acquired after ctx was done; give it back.
+		s.cur -= n
+	default:
+		s.waiters.Remove(elem)
+	}
+
+	// n.b. Removing a waiter (or returning its weight) may unblock those
+	//      behind it.
+	s.notifyUnsafe()
+	return ctx.Err()
+}
+
+// TryAcquire acquires the semaphore with a weight of n without blocking, and
+// reports whether it succeeded.
+func (s *Semaphore) TryAcquire(n int64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.size-s.cur >= n && s.waiters.Len() == 0 {
+		s.cur += n
+		return true
+	}
+	return false
+}
+
+// Release releases the semaphore with a weight of n. It panics if more weight
+// is released than is held.
+func (s *Semaphore) Release(n int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cur -= n
+	if s.cur < 0 {
+		panic("sync: semaphore released more than held")
+	}
+	s.notifyUnsafe()
+}
+
+func (s *Semaphore) notifyUnsafe() {
+	for {
+		front := s.waiters.Front()
+		if front == nil {
+			return
+		}
+
+		w := front.Value.(semaphoreWaiter) //nolint:errcheck
+		if s.size-s.cur < w.n {
+			return
+		}
+
+		s.cur += w.n
+		s.waiters.Remove(front)
+		close(w.ready)
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2026 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sync_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.mway.dev/x/sync"
+)
+
+func TestSemaphore(t *testing.T) {
+	sem := sync.NewSemaphore(3)
+
+	require.NoError(t, sem.Acquire(context.Background(), 2))
+	require.True(t, sem.TryAcquire(1))
+	require.False(t, sem.TryAcquire(1))
+
+	sem.Release(3)
+	require.True(t, sem.TryAcquire(3))
+	sem.Release(3)
+
+	require.Panics(t, func() { sem.Release(1) })
+}
+
+func TestSemaphore_AcquireBlocks(t *testing.T) {
+	sem := sync.NewSemaphore(2)
+	require.True(t, sem.TryAcquire(2))
+
+	acquired := make(chan struct{})
+	go func() {
+		defer close(acquired)
+		if err := sem.Acquire(context.Background(), 2); err != nil {
+			panic(err)
+		}
+	}()
+
+	sem.Release(1)
+	select {
+	case <-acquired:
+		require.FailNow(t, "acquired without enough weight")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	sem.Release(1)
+	<-acquired
+	require.False(t, sem.TryAcquire(1))
+}
+
+func TestSemaphore_AcquireContext(t *testing.T) {
+	sem := sync.NewSemaphore(1)
+	require.True(t, sem.TryAcquire(1))
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		10*time.Millisecond,
+	)
+	defer cancel()
+	require.ErrorIs(t, sem.Acquire(ctx, 1), context.DeadlineExceeded)
+
+	// A request larger than the semaphore can never succeed.
+	require.ErrorIs(t, sem.Acquire(ctx, 2), context.DeadlineExceeded)
+
+	sem.Release(1)
+	require.True(t, sem.TryAcquire(1))
+}
+
+func TestSemaphore_FIFO(t *testing.T) {
+	sem := sync.NewSemaphore(2)
+	require.True(t, sem.TryAcquire(2))
+
+	// A queued large request blocks smaller requests behind it.
+	large := make(chan struct{})
+	go func() {
+		defer close(large)
+		if err := sem.Acquire(context.Background(), 2); err != nil {
+			panic(err)
+		}
+	}()
+
+	// Free one unit at a time until the large request is queued, at which
+	// point the free unit can no longer be acquired.
+	require.Eventually(t, func() bool {
+		sem.Release(1)
+		return !sem.TryAcquire(1)
+	}, time.Second, time.Millisecond)
+
+	select {
+	case <-large:
+		require.FailNow(t, "acquired without enough weight")
+	default:
+	}
+
+	sem.Release(1)
+	<-large
+	sem.Release(2)
+	require.True(t, sem.TryAcquire(2))
+}
+
+func TestSemaphore_CanceledWaiterUnblocksOthers(t *testing.T) {
+	sem := sync.NewSemaphore(2)
+	require.True(t, sem.TryAcquire(1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	go func() {
+		errs <- sem.Acquire(ctx, 2)
+	}()
+
+	small := make(chan struct{})
+	go func() {
+		defer close(small)
+		if err := sem.Acquire(context.Background(), 1); err != nil {
+			panic(err)
+		}
+	}()
+
+	// Wait for the large request to be queued ahead of the small one.
+	require.Eventually(t, func() bool {
+		return !sem.TryAcquire(1)
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	require.ErrorIs(t, <-errs, context.Canceled)
+	<-small
+}
@@ -22,30 +22,64 @@
 package sync
 
 import (
+	"context"
+	"errors"
 	"sync"
 	"sync/atomic"
+	"time"
+
+	"go.mway.dev/x/internal/panics"
+)
+
+// An ErrorMode determines how a [WaitGroup] collects errors returned by
+// functions started with [WaitGroup.Go].
+type ErrorMode uint8
+
+const (
+	// FirstError configures a [WaitGroup] to retain only the first error
+	// returned by a function started with [WaitGroup.Go].
+	FirstError ErrorMode = iota
+	// JoinErrors configures a [WaitGroup] to retain all errors returned by
+	// functions started with [WaitGroup.Go], joined with [errors.Join].
+	JoinErrors
 )
 
 // WaitGroup is a wrapper around sync.WaitGroup that adds a length component.
 // It is a drop-in replacement that is functionally equivalent in every way
 // except that it also tracks the value of the underlying WaitGroup counter.
+//
+// In addition, a WaitGroup can start and track goroutines with [WaitGroup.Go]
+// (similar to an errgroup), optionally bounding how many run concurrently and
+// collecting their errors, and can be waited on with a context or timeout.
 type WaitGroup struct {
-	wg sync.WaitGroup
-	n  [1]int64
+	err  error
+	cond *Cond
+	sem  chan struct{}
+	errs []error
+	wg   sync.WaitGroup
+	n    [1]int64
+	mu   sync.Mutex
+	mode ErrorMode
 }
 
 // Add adds delta, which may be negative, to the WaitGroup counter. If the
 // counter becomes zero, all goroutines blocked on Wait are released. If the
 // counter goes negative, Add panics.
 func (g *WaitGroup) Add(delta int) {
-	atomic.AddInt64(&g.n[0], int64(delta))
+	n := atomic.AddInt64(&g.n[0], int64(delta))
 	g.wg.Add(delta)
+	if n == 0 {
+		g.notify()
+	}
 }
 
 // Done decrements the WaitGroup counter by one.
 func (g *WaitGroup) Done() {
-	atomic.AddInt64(&g.n[0], -1)
+	n := atomic.AddInt64(&g.n[0], -1)
 	g.wg.Done()
+	if n == 0 {
+		g.notify()
+	}
 }
 
 // Inc increments the WaitGroup counter by one.
@@ -62,3 +96,131 @@ func (g *WaitGroup) Len() int {
 func (g *WaitGroup) Wait() {
 	g.wg.Wait()
 }
+
+// WaitContext blocks until the WaitGroup counter is zero or ctx is done. If
+// ctx is done first, its error is returned; otherwise, any error collected
+// from functions started with [WaitGroup.Go] is returned.
+func (g *WaitGroup) WaitContext(ctx context.Context) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for g.Len() != 0 {
+		if g.cond == nil {
+			g.cond = NewCond(&g.mu)
+		}
+		if err := g.cond.WaitContext(ctx); err != nil {
+			return err
+		}
+	}
+	return g.errUnsafe()
+}
+
+// WaitTimeout blocks until the WaitGroup counter is zero or the given timeout
+// elapses. If the timeout elapses first, [context.DeadlineExceeded] is
+// returned; otherwise, any error collected from functions started with
+// [WaitGroup.Go] is returned.
+func (g *WaitGroup) WaitTimeout(timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return g.WaitContext(ctx)
+}
+
+// Go calls fn on a new goroutine, incrementing the WaitGroup counter until fn
+// returns. If a limit has been set with [WaitGroup.SetLimit], Go blocks until
+// fn can be started without exceeding it. Any error returned by fn is
+// collected according to the WaitGroup's [ErrorMode]; if fn panics, the panic
+// is recovered and collected as a [*PanicError].
+func (g *WaitGroup) Go(fn func() error) {
+	g.mu.Lock()
+	sem := g.sem
+	g.mu.Unlock()
+
+	g.Inc()
+	if sem != nil {
+		sem <- struct{}{}
+	}
+
+	go func() {
+		defer g.Done()
+		if sem != nil {
+			defer func() { <-sem }()
+		}
+		g.collect(callRecover(fn))
+	}()
+}
+
+// SetLimit limits the number of functions started with [WaitGroup.Go] that
+// may run concurrently to n. A negative n removes the limit. The limit only
+// applies to functions started after SetLimit returns. SetLimit panics if n
+// is zero, since no function could ever be started.
+func (g *WaitGroup) SetLimit(n int) {
+	if n == 0 {
+		panic("sync: WaitGroup limit must not be zero")
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if n < 0 {
+		g.sem = nil
+		return
+	}
+	g.sem = make(chan struct{}, n)
+}
+
+// SetErrorMode sets how errors returned by functions started with
+// [WaitGroup.Go] are collected. The default mode is [FirstError], which does
+// not retain any error after the first, so SetErrorMode should be called
+// before starting any functions.
+func (g *WaitGroup) SetErrorMode(mode ErrorMode) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.mode = mode
+}
+
+// Err returns any error collected so far from functions started with
+// [WaitGroup.Go], according to the WaitGroup's [ErrorMode].
+func (g *WaitGroup) Err() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.errUnsafe()
+}
+
+func (g *WaitGroup) errUnsafe() error {
+	if g.mode == JoinErrors && len(g.errs) > 0 {
+		return errors.Join(g.errs...)
+	}
+	return g.err
+}
+
+func (g *WaitGroup) collect(err error) {
+	if err == nil {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.err == nil {
+		g.err = err
+	}
+	if g.mode == JoinErrors {
+		g.errs = append(g.errs, err)
+	}
+}
+
+func (g *WaitGroup) notify() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.cond != nil {
+		g.cond.Broadcast()
+	}
+}
+
+// A PanicError is collected by a [WaitGroup] when a function started with
+// [WaitGroup.Go] panics.
+type PanicError = panics.Error
+
+func callRecover(fn func() error) (err error) {
+	defer panics.Recover(&err)
+	return fn()
+}
@@ -21,12 +21,16 @@
 package sync_test
 
 import (
+	"context"
+	"errors"
 	"math"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"go.mway.dev/x/future"
 	"go.mway.dev/x/sync"
 )
 
@@ -114,3 +118,171 @@ func TestWaitGroupLen(t *testing.T) {
 		wg.Add(1)
 	})
 }
+
+func TestWaitGroupWaitContext(t *testing.T) {
+	var wg sync.WaitGroup
+	require.NoError(t, wg.WaitContext(context.Background()))
+
+	wg.Add(2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, wg.WaitContext(ctx), context.Canceled)
+	require.ErrorIs(
+		t,
+		wg.WaitTimeout(10*time.Millisecond),
+		context.DeadlineExceeded,
+	)
+
+	time.AfterFunc(50*time.Millisecond, func() {
+		wg.Done()
+		wg.Done()
+	})
+	require.NoError(t, wg.WaitTimeout(time.Second))
+	require.Equal(t, 0, wg.Len())
+
+	// The WaitGroup is reusable.
+	wg.Inc()
+	time.AfterFunc(50*time.Millisecond, wg.Done)
+	require.NoError(t, wg.WaitContext(context.Background()))
+}
+
+func TestWaitGroupGo(t *testing.T) {
+	var (
+		wg      sync.WaitGroup
+		release = make(chan struct{})
+		calls   atomic.Int32
+	)
+
+	for range 10 {
+		wg.Go(func() error {
+			<-release
+			calls.Add(1)
+			return nil
+		})
+	}
+	require.Equal(t, 10, wg.Len())
+
+	close(release)
+	require.NoError(t, wg.WaitTimeout(time.Second))
+	require.EqualValues(t, 10, calls.Load())
+	require.Equal(t, 0, wg.Len())
+}
+
+func TestWaitGroupGoErrors(t *testing.T) {
+	var (
+		errA = errors.New("a")
+		errB = errors.New("b")
+	)
+
+	t.Run("first", func(t *testing.T) {
+		var wg sync.WaitGroup
+		wg.Go(func() error { return errA })
+		require.ErrorIs(t, wg.WaitTimeout(time.Second), errA)
+
+		wg.Go(func() error { return errB })
+		err := wg.WaitTimeout(time.Second)
+		require.ErrorIs(t, err, errA)
+		require.NotErrorIs(t, err, errB)
+
+		// Errors after the first are not retained in this mode.
+		wg.SetErrorMode(sync.JoinErrors)
+		require.ErrorIs(t, wg.Err(), errA)
+		require.NotErrorIs(t, wg.Err(), errB)
+	})
+
+	t.Run("join", func(t *testing.T) {
+		var wg sync.WaitGroup
+		wg.SetErrorMode(sync.JoinErrors)
+		wg.Go(func() error { return errA })
+		wg.Go(func() error { return nil })
+		wg.Go(func() error { return errB })
+
+		err := wg.WaitTimeout(time.Second)
+		require.ErrorIs(t, err, errA)
+		require.ErrorIs(t, err, errB)
+		require.ErrorIs(t, wg.Err(), errA)
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		var wg sync.WaitGroup
+		wg.SetErrorMode(sync.JoinErrors)
+		wg.Go(func() error { panic("oh no") })
+		wg.Go(func() error { panic(errA) })
+
+		err := wg.WaitTimeout(time.Second)
+
+		var perr *sync.PanicError
+		require.ErrorAs(t, err, &perr)
+		require.NotEmpty(t, perr.Stack)
+		require.Contains(t, perr.Error(), "panic: ")
+		require.ErrorIs(t, err, errA)
+
+		// Panics are reported with the same type across packages.
+		var fperr *future.PanicError
+		require.ErrorAs(t, err, &fperr)
+		require.Same(t, perr, fperr)
+	})
+}
+
+func TestWaitGroupSetLimit(t *testing.T) {
+	const limit = 3
+
+	var (
+		wg      sync.WaitGroup
+		running atomic.Int32
+		peak    atomic.Int32
+		started = make(chan struct{})
+	)
+
+	wg.SetLimit(limit)
+	go func() {
+		defer close(started)
+		for range 20 {
+			wg.Go(func() error {
+				n := running.Add(1)
+				defer running.Add(-1)
+				for {
+					cur := peak.Load()
+					if n <= cur || peak.CompareAndSwap(cur, n) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+				return nil
+			})
+		}
+	}()
+
+	<-started
+	require.NoError(t, wg.WaitTimeout(5*time.Second))
+	require.LessOrEqual(t, peak.Load(), int32(limit))
+
+	wg.SetLimit(-1)
+	for range 10 {
+		wg.Go(func() error { return nil })
+	}
+	require.NoError(t, wg.WaitTimeout(time.Second))
+
+	require.Panics(t, func() { wg.SetLimit(0) })
+}
+
+func TestWaitGroupSetLimitConcurrent(t *testing.T) {
+	var (
+		wg   sync.WaitGroup
+		done = make(chan struct{})
+	)
+
+	go func() {
+		defer close(done)
+		for i := range 100 {
+			wg.SetLimit(i%3 + 1)
+		}
+	}()
+
+	for range 100 {
+		wg.Go(func() error { return nil })
+	}
+	<-done
+	require.NoError(t, wg.WaitTimeout(time.Second))
+}
@@ -27,6 +27,8 @@ import (
 	"slices"
 	"strings"
 	"sync"
+
+	xsync "go.mway.dev/x/sync"
 )
 
 const (
@@ -35,9 +37,9 @@ const (
 
 // A Buffer buffers lines of text.
 type Buffer struct {
-	updates chan struct{}
 	done    chan struct{}
 	lines   []string
+	updates xsync.Notifier
 	mu      sync.RWMutex
 	stop    bool
 }
@@ -49,9 +51,8 @@ func New(size int, opts ...Option) *Buffer {
 	var (
 		options = bufferOptions{}.With(opts...)
 		b       = &Buffer{
-			lines:   make([]string, 0, size),
-			updates: make(chan struct{}, 1),
-			done:    make(chan struct{}),
+			lines: make([]string, 0, size),
+			done:  make(chan struct{}),
 		}
 	)
 
@@ -207,7 +208,7 @@ func (b *Buffer) SetCap(size int) {
 // Updates returns a channel that receives a message whenever there are line
 // updates.
 func (b *Buffer) Updates() <-chan struct{} {
-	return b.updates
+	return b.updates.C()
 }
 
 func (b *Buffer) isStopped() bool {
@@ -230,16 +231,5 @@ func (b *Buffer) sendUpdateUnsafe() {
 	if b.stop {
 		return
 	}
-
-	for {
-		select {
-		case b.updates <- struct{}{}:
-			return
-		default:
-			select {
-			case <-b.updates:
-			default:
-			}
-		}
-	}
+	b.updates.Notify()
 }